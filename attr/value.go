@@ -42,7 +42,10 @@ type Value interface {
 	// Additionally, most types should verify that known values are compared
 	// to comply with Terraform's data consistency rules. For example:
 	//
+	//  - Null values are equal to other null values, and unknown values are
+	//    equal to other unknown values, of the same type
 	//  - In a list, element order is significant
+	//  - In a set, element order is not significant, only membership
 	//  - In a string, runes are compared byte-wise (e.g. whitespace is
 	//    significant in JSON-encoded strings)
 	//
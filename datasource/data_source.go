@@ -5,6 +5,8 @@ package datasource
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // DataSource represents an instance of a data source type. This is the core
@@ -15,6 +17,8 @@ import (
 //   - Configure: Include provider-level data or clients.
 //   - Validation: Schema-based or entire configuration
 //     via DataSourceWithConfigValidators or DataSourceWithValidateConfig.
+//   - Known Configuration: Return an error instead of calling Read with
+//     unknown configuration values via DataSourceWithKnownConfigPaths.
 type DataSource interface {
 	// Metadata should return the full name of the data source, such as
 	// examplecloud_thing.
@@ -60,6 +64,21 @@ type DataSourceWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// DataSourceWithKnownConfigPaths is an interface type that extends DataSource
+// to declare configuration values which Read cannot handle if unknown.
+//
+// Terraform typically waits to read data sources until their configuration is
+// fully known. If Read would otherwise be called while a value matching one
+// of these path expressions is unknown, the framework instead returns an error
+// diagnostic for that value.
+type DataSourceWithKnownConfigPaths interface {
+	DataSource
+
+	// KnownConfigPaths returns path expressions of configuration values which
+	// must be known before Read is called.
+	KnownConfigPaths(context.Context) path.Expressions
+}
+
 // DataSourceWithValidateConfig is an interface type that extends DataSource to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
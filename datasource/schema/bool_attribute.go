@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                             = BoolAttribute{}
+	_ fwschema.AttributeWithLogSensitive    = BoolAttribute{}
 	_ fwxschema.AttributeWithBoolValidators = BoolAttribute{}
 )
 
@@ -60,6 +61,10 @@ type BoolAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and read, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -185,3 +190,8 @@ func (a BoolAttribute) IsRequired() bool {
 func (a BoolAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsLogSensitive returns the LogSensitive field value.
+func (a BoolAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
@@ -423,3 +423,37 @@ func TestBoolAttributeIsSensitive(t *testing.T) {
 		})
 	}
 }
+
+func TestBoolAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.BoolAttribute{},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.BoolAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
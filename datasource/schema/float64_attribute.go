@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                = Float64Attribute{}
+	_ fwschema.AttributeWithLogSensitive       = Float64Attribute{}
 	_ fwxschema.AttributeWithFloat64Validators = Float64Attribute{}
 )
 
@@ -63,6 +64,10 @@ type Float64Attribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and read, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -188,3 +193,8 @@ func (a Float64Attribute) IsRequired() bool {
 func (a Float64Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsLogSensitive returns the LogSensitive field value.
+func (a Float64Attribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
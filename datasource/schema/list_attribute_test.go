@@ -398,6 +398,40 @@ func TestListAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestListAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.ListAttribute{ElementType: types.StringType},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.ListAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeListValidators(t *testing.T) {
 	t.Parallel()
 
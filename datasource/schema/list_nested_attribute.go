@@ -18,6 +18,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                       = ListNestedAttribute{}
+	_ fwschema.AttributeWithLogSensitive    = ListNestedAttribute{}
 	_ fwxschema.AttributeWithListValidators = ListNestedAttribute{}
 )
 
@@ -82,6 +83,10 @@ type ListNestedAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and read, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -130,6 +135,16 @@ type ListNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// MinItems is the minimum number of elements that must be configured.
+	// Zero, the default, means there is no minimum. Null and unknown values
+	// are not checked.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements that can be configured.
+	// Zero, the default, means there is no maximum. Null and unknown values
+	// are not checked.
+	MaxItems int64
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -182,6 +197,16 @@ func (a ListNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMaxItems returns the MaxItems field value.
+func (a ListNestedAttribute) GetMaxItems() int64 {
+	return a.MaxItems
+}
+
+// GetMinItems returns the MinItems field value.
+func (a ListNestedAttribute) GetMinItems() int64 {
+	return a.MinItems
+}
+
 // GetNestedObject returns the NestedObject field value.
 func (a ListNestedAttribute) GetNestedObject() fwschema.NestedAttributeObject {
 	return a.NestedObject
@@ -223,6 +248,11 @@ func (a ListNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a ListNestedAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ListValidators returns the Validators field value.
 func (a ListNestedAttribute) ListValidators() []validator.List {
 	return a.Validators
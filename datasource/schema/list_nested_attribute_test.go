@@ -565,6 +565,46 @@ func TestListNestedAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestListNestedAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListNestedAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"testattr": schema.StringAttribute{},
+					},
+				},
+			},
+			expected: false,
+		},
+		"log-sensitive": {
+			attribute: schema.ListNestedAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListNestedAttributeListValidators(t *testing.T) {
 	t.Parallel()
 
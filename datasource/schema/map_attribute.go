@@ -18,6 +18,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                    = MapAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = MapAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = MapAttribute{}
 	_ fwxschema.AttributeWithMapValidators         = MapAttribute{}
 )
@@ -77,6 +78,10 @@ type MapAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and read, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -200,6 +205,11 @@ func (a MapAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a MapAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // MapValidators returns the Validators field value.
 func (a MapAttribute) MapValidators() []validator.Map {
 	return a.Validators
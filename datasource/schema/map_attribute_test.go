@@ -398,6 +398,40 @@ func TestMapAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestMapAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.MapAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.MapAttribute{ElementType: types.StringType},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.MapAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapAttributeMapValidators(t *testing.T) {
 	t.Parallel()
 
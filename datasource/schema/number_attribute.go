@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                               = NumberAttribute{}
+	_ fwschema.AttributeWithLogSensitive      = NumberAttribute{}
 	_ fwxschema.AttributeWithNumberValidators = NumberAttribute{}
 )
 
@@ -64,6 +65,10 @@ type NumberAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and read, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -185,6 +190,11 @@ func (a NumberAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a NumberAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // NumberValidators returns the Validators field value.
 func (a NumberAttribute) NumberValidators() []validator.Number {
 	return a.Validators
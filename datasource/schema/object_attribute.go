@@ -18,6 +18,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                    = ObjectAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = ObjectAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ObjectAttribute{}
 	_ fwxschema.AttributeWithObjectValidators      = ObjectAttribute{}
 )
@@ -76,6 +77,10 @@ type ObjectAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and read, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -199,6 +204,11 @@ func (a ObjectAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a ObjectAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ObjectValidators returns the Validators field value.
 func (a ObjectAttribute) ObjectValidators() []validator.Object {
 	return a.Validators
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -149,6 +150,7 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 
 		diags.Append(fwschema.IsReservedResourceAttributeName(req.Name, req.Path)...)
 		diags.Append(fwschema.ValidateAttributeImplementation(ctx, attribute, req)...)
+		diags.Append(fwxschema.ValidateDataSourceAttributeImplementation(ctx, attribute, req)...)
 	}
 
 	for blockName, block := range s.GetBlocks() {
@@ -159,11 +161,22 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 
 		diags.Append(fwschema.IsReservedResourceAttributeName(req.Name, req.Path)...)
 		diags.Append(fwschema.ValidateBlockImplementation(ctx, block, req)...)
+		diags.Append(fwxschema.ValidateDataSourceBlockImplementation(ctx, block, req)...)
 	}
 
 	return diags
 }
 
+// WalkAttributes calls the function for every attribute in the schema,
+// including nested attributes and attributes within blocks, with the path
+// expression matching the attribute values. Collection elements are
+// represented with expression steps matching any element, such as
+// AtAnyListIndex. The order is deterministic, which is useful for
+// documentation generators and linters.
+func (s Schema) WalkAttributes(ctx context.Context, fn func(path.Expression, fwschema.Attribute)) {
+	fwschema.SchemaWalkAttributes(ctx, s, fn)
+}
+
 // schemaAttributes is a datasource to fwschema type conversion function.
 func schemaAttributes(attributes map[string]Attribute) map[string]fwschema.Attribute {
 	result := make(map[string]fwschema.Attribute, len(attributes))
@@ -17,6 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -1085,6 +1090,92 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-resource-plan-modifiers": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": resourceschema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has the PlanModifiers field set in a data source schema. "+
+						"This field is only supported in resource schemas and is ignored.",
+				),
+			},
+		},
+		"attribute-resource-default": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": resourceschema.StringAttribute{
+						Computed: true,
+						Default:  stringdefault.StaticString("test"),
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has the Default field set in a data source schema. "+
+						"This field is only supported in resource schemas and is ignored.",
+				),
+			},
+		},
+		"nested-attribute-resource-plan-modifiers": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test": resourceschema.StringAttribute{
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+						Computed: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test\" has the PlanModifiers field set in a data source schema. "+
+						"This field is only supported in resource schemas and is ignored.",
+				),
+			},
+		},
+		"block-resource-plan-modifiers": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": resourceschema.ListNestedBlock{
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Block Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has the PlanModifiers field set in a data source schema. "+
+						"This field is only supported in resource schemas and is ignored.",
+				),
+			},
+		},
 		"nested-attribute-using-nested-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
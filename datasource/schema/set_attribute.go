@@ -18,6 +18,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                    = SetAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = SetAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = SetAttribute{}
 	_ fwxschema.AttributeWithSetValidators         = SetAttribute{}
 )
@@ -72,6 +73,10 @@ type SetAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and read, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -195,6 +200,11 @@ func (a SetAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a SetAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // SetValidators returns the Validators field value.
 func (a SetAttribute) SetValidators() []validator.Set {
 	return a.Validators
@@ -398,6 +398,40 @@ func TestSetAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestSetAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.SetAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.SetAttribute{ElementType: types.StringType},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.SetAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetAttributeSetValidators(t *testing.T) {
 	t.Parallel()
 
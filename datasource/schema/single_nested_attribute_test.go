@@ -529,6 +529,44 @@ func TestSingleNestedAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestSingleNestedAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.SingleNestedAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+			},
+			expected: false,
+		},
+		"log-sensitive": {
+			attribute: schema.SingleNestedAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSingleNestedAttributeObjectValidators(t *testing.T) {
 	t.Parallel()
 
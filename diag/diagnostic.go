@@ -34,7 +34,11 @@ type Diagnostic interface {
 	// practitioners or provider developers.
 	Detail() string
 
-	// Equal returns true if the other diagnostic is wholly equivalent.
+	// Equal returns true if the other diagnostic is wholly equivalent. The
+	// generic implementations compare the concrete type, severity, summary,
+	// and detail. Diagnostics created with WithPath() or the NewAttribute*
+	// functions additionally compare the path, so a diagnostic with a path is
+	// never equal to one without.
 	Equal(Diagnostic) bool
 }
 
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithPathEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.DiagnosticWithPath
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: true,
+		},
+		"matching-nested-path": {
+			diag:     diag.NewAttributeWarningDiagnostic(path.Root("test").AtListIndex(0).AtName("nested"), "test summary", "test detail"),
+			other:    diag.NewAttributeWarningDiagnostic(path.Root("test").AtListIndex(0).AtName("nested"), "test summary", "test detail"),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    nil,
+			expected: false,
+		},
+		"different-detail": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "different detail"),
+			expected: false,
+		},
+		"different-path": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("different"), "test summary", "test detail"),
+			expected: false,
+		},
+		"different-path-step": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(1), "test summary", "test detail"),
+			expected: false,
+		},
+		"different-severity": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeWarningDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: false,
+		},
+		"different-summary": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "different summary", "test detail"),
+			expected: false,
+		},
+		"different-type-without-path": {
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			other:    diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: false,
+		},
+		"overwritten-path": {
+			diag:     diag.WithPath(path.Root("test"), diag.NewAttributeErrorDiagnostic(path.Root("original"), "test summary", "test detail")),
+			other:    diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
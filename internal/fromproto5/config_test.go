@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -83,7 +84,8 @@ func TestConfig(t *testing.T) {
 			schema:   testFwSchemaInvalid,
 			expected: nil,
 			expectedDiagnostics: diag.Diagnostics{
-				diag.NewErrorDiagnostic(
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attribute"),
 					"Unable to Convert Configuration",
 					"An unexpected error was encountered when converting the configuration from the protocol type. "+
 						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
@@ -5,6 +5,7 @@ package fromproto5_test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -13,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -29,6 +32,82 @@ func DynamicValueMust(value tftypes.Value) *tfprotov5.DynamicValue {
 	return &dynamicValue
 }
 
+func BenchmarkDynamicValue1000(b *testing.B) {
+	benchmarkDynamicValue(b, 1000, false, false)
+}
+
+func BenchmarkDynamicValue1000WithBlock(b *testing.B) {
+	benchmarkDynamicValue(b, 1000, true, false)
+}
+
+func BenchmarkDynamicValue1000WithTerraformType(b *testing.B) {
+	benchmarkDynamicValue(b, 1000, false, true)
+}
+
+func BenchmarkDynamicValue1000WithBlockWithTerraformType(b *testing.B) {
+	benchmarkDynamicValue(b, 1000, true, true)
+}
+
+func benchmarkDynamicValue(b *testing.B, attributes int, withBlock bool, withTerraformType bool) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: make(map[string]schema.Attribute, attributes),
+	}
+	testAttributeTypes := make(map[string]tftypes.Type, attributes)
+	testAttributeValues := make(map[string]tftypes.Value, attributes)
+
+	for i := 0; i < attributes; i++ {
+		attributeName := "testattr" + strconv.Itoa(i)
+		testSchema.Attributes[attributeName] = schema.StringAttribute{
+			Optional: true,
+		}
+		testAttributeTypes[attributeName] = tftypes.String
+		testAttributeValues[attributeName] = tftypes.NewValue(tftypes.String, "test-value")
+	}
+
+	if withBlock {
+		testSchema.Blocks = map[string]schema.Block{
+			"test_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		}
+		testBlockType := tftypes.List{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_attribute": tftypes.String,
+				},
+			},
+		}
+		testAttributeTypes["test_block"] = testBlockType
+		testAttributeValues["test_block"] = tftypes.NewValue(testBlockType, []tftypes.Value{})
+	}
+
+	proto5 := DynamicValueMust(tftypes.NewValue(tftypes.Object{AttributeTypes: testAttributeTypes}, testAttributeValues))
+
+	var benchmarkSchema fwschema.Schema = testSchema
+
+	if withTerraformType {
+		benchmarkSchema = fwschema.NewSchemaWithTerraformType(ctx, testSchema)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, diags := fromproto5.DynamicValue(ctx, proto5, benchmarkSchema, fwschemadata.DataDescriptionConfiguration)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected DynamicValue diagnostics: %s", diags)
+		}
+	}
+}
+
 func TestDynamicValue(t *testing.T) {
 	t.Parallel()
 
@@ -100,7 +179,8 @@ func TestDynamicValue(t *testing.T) {
 				TerraformValue: tftypes.Value{},
 			},
 			expectedDiags: diag.Diagnostics{
-				diag.NewErrorDiagnostic(
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
 					"Unable to Convert Configuration",
 					"An unexpected error was encountered when converting the configuration from the protocol type. "+
 						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
@@ -109,6 +189,91 @@ func TestDynamicValue(t *testing.T) {
 				),
 			},
 		},
+		"unmarshal-error-nested": {
+			proto5: DynamicValueMust(tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_nested": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_nested": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_nested": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_nested": tftypes.NewValue(tftypes.String, "test-value"),
+								},
+							),
+						},
+					),
+				},
+			)),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_nested": testschema.Attribute{
+									Optional: true,
+									Type:     types.BoolType, // intentional for testing error
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+						Optional:    true,
+					},
+				},
+			},
+			description: fwschemadata.DataDescriptionConfiguration,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"test_nested": testschema.Attribute{
+										Optional: true,
+										Type:     types.BoolType, // intentional for testing error
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0).AtName("test_nested"),
+					"Unable to Convert Configuration",
+					"An unexpected error was encountered when converting the configuration from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: AttributeName(\"test\").ElementKeyInt(0).AttributeName(\"test_nested\"): couldn't decode bool: msgpack: invalid code=aa decoding bool",
+				),
+			},
+		},
 		"attribute-value": {
 			proto5: DynamicValueMust(tftypes.NewValue(
 				tftypes.Object{
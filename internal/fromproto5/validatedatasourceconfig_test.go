@@ -61,6 +61,14 @@ func TestValidateDataSourceConfigRequest(t *testing.T) {
 			input:    &tfprotov5.ValidateDataSourceConfigRequest{},
 			expected: &fwserver.ValidateDataSourceConfigRequest{},
 		},
+		"typename": {
+			input: &tfprotov5.ValidateDataSourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateDataSourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov5.ValidateDataSourceConfigRequest{
 				Config: &testProto5DynamicValue,
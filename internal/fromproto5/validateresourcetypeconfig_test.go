@@ -61,6 +61,14 @@ func TestValidateResourceTypeConfigRequest(t *testing.T) {
 			input:    &tfprotov5.ValidateResourceTypeConfigRequest{},
 			expected: &fwserver.ValidateResourceConfigRequest{},
 		},
+		"typename": {
+			input: &tfprotov5.ValidateResourceTypeConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov5.ValidateResourceTypeConfigRequest{
 				Config: &testProto5DynamicValue,
@@ -5,11 +5,14 @@ package fromproto6
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // DynamicValue returns the fwschemadata.Data for a given
@@ -32,16 +35,30 @@ func DynamicValue(ctx context.Context, proto6 *tfprotov6.DynamicValue, schema fw
 		return *data, diags
 	}
 
-	proto6Value, err := proto6.Unmarshal(schema.Type().TerraformType(ctx))
+	proto6Value, err := proto6.Unmarshal(fwschema.SchemaTerraformType(ctx, schema))
 
 	if err != nil {
-		diags.AddError(
-			"Unable to Convert "+description.Title(),
-			"An unexpected error was encountered when converting the "+description.String()+" from the protocol type. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Unable to unmarshal DynamicValue: "+err.Error(),
-		)
+		summary := "Unable to Convert " + description.Title()
+		detail := "An unexpected error was encountered when converting the " + description.String() + " from the protocol type. " +
+			"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n" +
+			"Please report this to the provider developer:\n\n" +
+			"Unable to unmarshal DynamicValue: " + err.Error()
+
+		// Scope the diagnostic to the attribute which could not be decoded,
+		// if the error contains that information, to make it actionable.
+		var attributePathErr tftypes.AttributePathError
+
+		if errors.As(err, &attributePathErr) && len(attributePathErr.Path.Steps()) > 0 {
+			attributePath, attributePathDiags := fromtftypes.AttributePath(ctx, attributePathErr.Path, schema)
+
+			if !attributePathDiags.HasError() {
+				diags.AddAttributeError(attributePath, summary, detail)
+
+				return *data, diags
+			}
+		}
+
+		diags.AddError(summary, detail)
 
 		return *data, diags
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -100,7 +101,8 @@ func TestDynamicValue(t *testing.T) {
 				TerraformValue: tftypes.Value{},
 			},
 			expectedDiags: diag.Diagnostics{
-				diag.NewErrorDiagnostic(
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
 					"Unable to Convert Configuration",
 					"An unexpected error was encountered when converting the configuration from the protocol type. "+
 						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
@@ -109,6 +111,91 @@ func TestDynamicValue(t *testing.T) {
 				),
 			},
 		},
+		"unmarshal-error-nested": {
+			proto6: DynamicValueMust(tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_nested": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_nested": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_nested": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_nested": tftypes.NewValue(tftypes.String, "test-value"),
+								},
+							),
+						},
+					),
+				},
+			)),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_nested": testschema.Attribute{
+									Optional: true,
+									Type:     types.BoolType, // intentional for testing error
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+						Optional:    true,
+					},
+				},
+			},
+			description: fwschemadata.DataDescriptionConfiguration,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"test_nested": testschema.Attribute{
+										Optional: true,
+										Type:     types.BoolType, // intentional for testing error
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0).AtName("test_nested"),
+					"Unable to Convert Configuration",
+					"An unexpected error was encountered when converting the configuration from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: AttributeName(\"test\").ElementKeyInt(0).AttributeName(\"test_nested\"): couldn't decode bool: msgpack: invalid code=aa decoding bool",
+				),
+			},
+		},
 		"attribute-value": {
 			proto6: DynamicValueMust(tftypes.NewValue(
 				tftypes.Object{
@@ -61,6 +61,14 @@ func TestValidateDataSourceConfigRequest(t *testing.T) {
 			input:    &tfprotov6.ValidateDataResourceConfigRequest{},
 			expected: &fwserver.ValidateDataSourceConfigRequest{},
 		},
+		"typename": {
+			input: &tfprotov6.ValidateDataResourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateDataSourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov6.ValidateDataResourceConfigRequest{
 				Config: &testProto6DynamicValue,
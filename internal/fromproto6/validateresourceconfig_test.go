@@ -61,6 +61,14 @@ func TestValidateResourceConfigRequest(t *testing.T) {
 			input:    &tfprotov6.ValidateResourceConfigRequest{},
 			expected: &fwserver.ValidateResourceConfigRequest{},
 		},
+		"typename": {
+			input: &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_type",
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov6.ValidateResourceConfigRequest{
 				Config: &testProto6DynamicValue,
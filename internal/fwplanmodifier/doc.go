@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwplanmodifier contains shared logic for the plan modifiers in the
+// resource/schema/*planmodifier packages.
+package fwplanmodifier
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// PathsChanged returns true if the planned value of any attribute matching the
+// given path expressions differs from its prior state value. Relative path
+// expressions are resolved from the given attribute path expression.
+//
+// The plan should be the plan as modified by earlier plan modifiers, such as
+// the Plan field of the plan modifier response, so those changes are taken
+// into account.
+func PathsChanged(ctx context.Context, pathExpression path.Expression, expressions path.Expressions, plan tfsdk.Plan, state tfsdk.State) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, expression := range pathExpression.MergeExpressions(expressions...) {
+		matchedPaths, matchedPathsDiags := plan.PathMatches(ctx, expression)
+
+		diags.Append(matchedPathsDiags...)
+
+		if matchedPathsDiags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			planValue, planValueDiags := plan.GetAttributeValue(ctx, matchedPath)
+
+			diags.Append(planValueDiags...)
+
+			if planValueDiags.HasError() {
+				continue
+			}
+
+			stateValue, stateValueDiags := state.GetAttributeValue(ctx, matchedPath)
+
+			diags.Append(stateValueDiags...)
+
+			if stateValueDiags.HasError() {
+				continue
+			}
+
+			if !planValue.Equal(stateValue) {
+				return true, diags
+			}
+		}
+	}
+
+	return false, diags
+}
@@ -101,5 +101,9 @@ func AttributesEqual(a, b Attribute) bool {
 		return false
 	}
 
+	if AttributeIsLogSensitive(a) != AttributeIsLogSensitive(b) {
+		return false
+	}
+
 	return true
 }
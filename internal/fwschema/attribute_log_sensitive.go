@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithLogSensitive is an optional interface on Attribute which
+// enables masking of the attribute value in framework logs and diagnostics,
+// separately from the Terraform protocol sensitivity of the attribute.
+type AttributeWithLogSensitive interface {
+	Attribute
+
+	// IsLogSensitive should return true if the attribute configuration value
+	// should be masked in logs and diagnostics, but not necessarily obscured
+	// in Terraform CLI output.
+	IsLogSensitive() bool
+}
+
+// AttributeIsLogSensitive returns true if the attribute value should be masked
+// in framework logs and diagnostics. This is true if the attribute is
+// sensitive, since that implies sensitivity everywhere, or if the attribute
+// implements AttributeWithLogSensitive and IsLogSensitive returns true.
+func AttributeIsLogSensitive(a Attribute) bool {
+	if a.IsSensitive() {
+		return true
+	}
+
+	logSensitiveAttribute, ok := a.(AttributeWithLogSensitive)
+
+	if !ok {
+		return false
+	}
+
+	return logSensitiveAttribute.IsLogSensitive()
+}
@@ -30,6 +30,7 @@ type AttributeWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - Checks whether Required is set alongside Optional or Computed
 //   - If the given Attribute implements the
 //     AttributeWithValidateImplementation interface, calls the method
 //   - If the given Attribute implements the NestedAttribute interface,
@@ -39,6 +40,10 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 
 	diags.Append(IsValidAttributeName(req.Name, req.Path)...)
 
+	if attribute.IsRequired() && (attribute.IsOptional() || attribute.IsComputed()) {
+		diags.Append(AttributeConflictingRequiredDiag(req.Path))
+	}
+
 	if attributeWithValidateImplementation, ok := attribute.(AttributeWithValidateImplementation); ok {
 		resp := &ValidateImplementationResponse{}
 
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+)
+
+// SchemaMissingDescriptionPaths returns the path of every attribute or block
+// in the schema, including nested attributes and blocks, which has neither a
+// Description nor MarkdownDescription set. This is intended for
+// documentation completeness checks and is not part of the schema
+// implementation validation, since missing descriptions are valid.
+func SchemaMissingDescriptionPaths(_ context.Context, s Schema) path.Paths {
+	return objectMissingDescriptionPaths(path.Empty(), s.GetAttributes(), s.GetBlocks())
+}
+
+// objectMissingDescriptionPaths checks the given attributes and blocks, in
+// name order for deterministic results, and recurses into nested objects.
+func objectMissingDescriptionPaths(parentPath path.Path, attributes map[string]Attribute, blocks map[string]Block) path.Paths {
+	var result path.Paths
+
+	attributeNames := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		attributeNames = append(attributeNames, name)
+	}
+
+	sort.Strings(attributeNames)
+
+	for _, name := range attributeNames {
+		attribute := attributes[name]
+		attributePath := parentPath.AtName(name)
+
+		if description, _ := schema.EffectiveDescription(attribute); description == "" {
+			result.Append(attributePath)
+		}
+
+		nestedAttribute, ok := attribute.(NestedAttribute)
+
+		if !ok || nestedAttribute.GetNestedObject() == nil {
+			continue
+		}
+
+		result.Append(objectMissingDescriptionPaths(attributePath, nestedAttribute.GetNestedObject().GetAttributes(), nil)...)
+	}
+
+	blockNames := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		blockNames = append(blockNames, name)
+	}
+
+	sort.Strings(blockNames)
+
+	for _, name := range blockNames {
+		block := blocks[name]
+		blockPath := parentPath.AtName(name)
+
+		if description, _ := schema.EffectiveDescription(block); description == "" {
+			result.Append(blockPath)
+		}
+
+		nestedObject := block.GetNestedObject()
+
+		if nestedObject == nil {
+			continue
+		}
+
+		result.Append(objectMissingDescriptionPaths(blockPath, nestedObject.GetAttributes(), nestedObject.GetBlocks())...)
+	}
+
+	return result
+}
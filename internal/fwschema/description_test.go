@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaMissingDescriptionPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   fwschema.Schema
+		expected path.Paths
+	}{
+		"no-attributes": {
+			schema: testschema.Schema{},
+		},
+		"described": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_description": testschema.Attribute{
+						Description: "test description",
+						Optional:    true,
+						Type:        types.StringType,
+					},
+					"test_markdown_description": testschema.Attribute{
+						MarkdownDescription: "test description",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"test_block": testschema.Block{
+						Description: "test description",
+						NestedObject: testschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_nested": testschema.Attribute{
+									Description: "test description",
+									Optional:    true,
+									Type:        types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+				},
+			},
+		},
+		"missing": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_b": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"test_a": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"test_block": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Blocks: map[string]fwschema.Block{
+								"test_nested_block": testschema.Block{
+									Description: "test description",
+									NestedObject: testschema.NestedBlockObject{
+										Attributes: map[string]fwschema.Attribute{
+											"test_nested": testschema.Attribute{
+												Optional: true,
+												Type:     types.StringType,
+											},
+										},
+									},
+									NestingMode: fwschema.BlockNestingModeList,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+				},
+			},
+			expected: path.Paths{
+				path.Root("test_a"),
+				path.Root("test_b"),
+				path.Root("test_block"),
+				path.Root("test_block").AtName("test_nested_block").AtName("test_nested"),
+			},
+		},
+		"missing-nested-attribute": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_nested_attribute": testschema.NestedAttribute{
+						Description: "test description",
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_nested": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeSingle,
+						Optional:    true,
+					},
+				},
+			},
+			expected: path.Paths{
+				path.Root("test_nested_attribute").AtName("test_nested"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.SchemaMissingDescriptionPaths(context.Background(), testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -64,3 +64,73 @@ func AttributeDefaultTypeMismatchDiag(attributePath path.Path, expectedType attr
 			"The default value must match the type of the schema.",
 	)
 }
+
+// AttributeConflictingRequiredDiag returns an error diagnostic to provider
+// developers about setting the Required field alongside the Optional or
+// Computed fields on an Attribute implementation. Terraform rejects these
+// combinations when loading the provider schema.
+func AttributeConflictingRequiredDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has Required set alongside Optional or Computed. ", attributePath)+
+			"Required attributes cannot also be Optional or Computed.",
+	)
+}
+
+// AttributeResourceOnlyFieldDiag returns a warning diagnostic to provider
+// developers about setting a resource-only field, such as PlanModifiers or
+// Default, on an Attribute implementation within a data source or provider
+// schema. These fields are ignored, which is not an error for existing
+// providers, so this only surfaces the otherwise silent behavior.
+func AttributeResourceOnlyFieldDiag(attributePath path.Path, fieldName string, schemaKind string) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewWarningDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has the %s field set in a %s schema. ", attributePath, fieldName, schemaKind)+
+			"This field is only supported in resource schemas and is ignored.",
+	)
+}
+
+// BlockResourceOnlyFieldDiag returns a warning diagnostic to provider
+// developers about setting a resource-only field, such as PlanModifiers, on a
+// Block implementation within a data source or provider schema. Refer to
+// AttributeResourceOnlyFieldDiag for why this is not an error.
+func BlockResourceOnlyFieldDiag(blockPath path.Path, fieldName string, schemaKind string) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewWarningDiagnostic(
+		"Invalid Block Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has the %s field set in a %s schema. ", blockPath, fieldName, schemaKind)+
+			"This field is only supported in resource schemas and is ignored.",
+	)
+}
+
+// AttributeComputedInProviderSchemaDiag returns a warning diagnostic to
+// provider developers about setting the Computed field on an Attribute
+// implementation within a provider schema. Provider configuration is only
+// ever written by practitioners, so Terraform never sets computed values.
+// Providers with such attributes have always worked, so this is not an error.
+func AttributeComputedInProviderSchemaDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewWarningDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has the Computed field set in a provider schema. ", attributePath)+
+			"Provider schema attributes should be Required or Optional.",
+	)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwxschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// ValidateDataSourceAttributeImplementation contains the data source specific
+// Attribute implementation validation logic. It is intended to be called
+// alongside fwschema.ValidateAttributeImplementation.
+//
+// This logic currently:
+//   - Checks whether the given Attribute declares resource-only plan
+//     modifiers or default values, which data sources would ignore
+//   - If the given Attribute implements the NestedAttribute interface,
+//     recursively calls this function on nested attributes
+func ValidateDataSourceAttributeImplementation(ctx context.Context, attribute fwschema.Attribute, req fwschema.ValidateImplementationRequest) diag.Diagnostics {
+	return validateNonResourceAttributeImplementation(ctx, attribute, req, "data source", true)
+}
+
+// ValidateDataSourceBlockImplementation contains the data source specific
+// Block implementation validation logic. It is intended to be called
+// alongside fwschema.ValidateBlockImplementation.
+//
+// This logic currently:
+//   - Checks whether the given Block declares resource-only plan modifiers,
+//     which data sources would ignore
+//   - Recursively calls the data source validation on nested attributes and
+//     blocks
+func ValidateDataSourceBlockImplementation(ctx context.Context, block fwschema.Block, req fwschema.ValidateImplementationRequest) diag.Diagnostics {
+	return validateNonResourceBlockImplementation(ctx, block, req, "data source", true)
+}
+
+// ValidateProviderAttributeImplementation contains the provider specific
+// Attribute implementation validation logic. It is intended to be called
+// alongside fwschema.ValidateAttributeImplementation.
+//
+// This logic currently:
+//   - Checks whether the given Attribute declares resource-only plan
+//     modifiers or default values, which providers would ignore
+//   - Checks whether the given Attribute is Computed, since provider
+//     configuration is only ever written by practitioners
+//   - If the given Attribute implements the NestedAttribute interface,
+//     recursively calls this function on nested attributes
+func ValidateProviderAttributeImplementation(ctx context.Context, attribute fwschema.Attribute, req fwschema.ValidateImplementationRequest) diag.Diagnostics {
+	return validateNonResourceAttributeImplementation(ctx, attribute, req, "provider", false)
+}
+
+// ValidateProviderBlockImplementation contains the provider specific Block
+// implementation validation logic. It is intended to be called alongside
+// fwschema.ValidateBlockImplementation.
+//
+// This logic currently:
+//   - Checks whether the given Block declares resource-only plan modifiers,
+//     which providers would ignore
+//   - Recursively calls the provider validation on nested attributes and
+//     blocks
+func ValidateProviderBlockImplementation(ctx context.Context, block fwschema.Block, req fwschema.ValidateImplementationRequest) diag.Diagnostics {
+	return validateNonResourceBlockImplementation(ctx, block, req, "provider", false)
+}
+
+func validateNonResourceAttributeImplementation(ctx context.Context, attribute fwschema.Attribute, req fwschema.ValidateImplementationRequest, schemaKind string, allowComputed bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if attributeHasPlanModifiers(attribute) {
+		diags.Append(fwschema.AttributeResourceOnlyFieldDiag(req.Path, "PlanModifiers", schemaKind))
+	}
+
+	if attributeHasDefaultValue(attribute) {
+		diags.Append(fwschema.AttributeResourceOnlyFieldDiag(req.Path, "Default", schemaKind))
+	}
+
+	if !allowComputed && attribute.IsComputed() {
+		diags.Append(fwschema.AttributeComputedInProviderSchemaDiag(req.Path))
+	}
+
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return diags
+	}
+
+	nestedObject := nestedAttribute.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	if nestedObjectWithPlanModifiers, ok := nestedObject.(NestedAttributeObjectWithPlanModifiers); ok && len(nestedObjectWithPlanModifiers.ObjectPlanModifiers()) > 0 {
+		diags.Append(fwschema.AttributeResourceOnlyFieldDiag(req.Path, "PlanModifiers", schemaKind))
+	}
+
+	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
+		// Refer to the fwschema.ValidateAttributeImplementation path comment.
+		nestedReq := fwschema.ValidateImplementationRequest{
+			Name: nestedAttributeName,
+			Path: req.Path.AtName(nestedAttributeName),
+		}
+
+		diags.Append(validateNonResourceAttributeImplementation(ctx, nestedAttribute, nestedReq, schemaKind, allowComputed)...)
+	}
+
+	return diags
+}
+
+func validateNonResourceBlockImplementation(ctx context.Context, block fwschema.Block, req fwschema.ValidateImplementationRequest, schemaKind string, allowComputed bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if blockHasPlanModifiers(block) {
+		diags.Append(fwschema.BlockResourceOnlyFieldDiag(req.Path, "PlanModifiers", schemaKind))
+	}
+
+	nestedObject := block.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	if nestedObjectWithPlanModifiers, ok := nestedObject.(NestedBlockObjectWithPlanModifiers); ok && len(nestedObjectWithPlanModifiers.ObjectPlanModifiers()) > 0 {
+		diags.Append(fwschema.BlockResourceOnlyFieldDiag(req.Path, "PlanModifiers", schemaKind))
+	}
+
+	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
+		// Refer to the fwschema.ValidateBlockImplementation path comment.
+		nestedReq := fwschema.ValidateImplementationRequest{
+			Name: nestedAttributeName,
+			Path: req.Path.AtName(nestedAttributeName),
+		}
+
+		diags.Append(validateNonResourceAttributeImplementation(ctx, nestedAttribute, nestedReq, schemaKind, allowComputed)...)
+	}
+
+	for nestedBlockName, nestedBlock := range nestedObject.GetBlocks() {
+		nestedReq := fwschema.ValidateImplementationRequest{
+			Name: nestedBlockName,
+			Path: req.Path.AtName(nestedBlockName),
+		}
+
+		diags.Append(validateNonResourceBlockImplementation(ctx, nestedBlock, nestedReq, schemaKind, allowComputed)...)
+	}
+
+	return diags
+}
+
+// attributeHasPlanModifiers returns true if the given Attribute implements
+// any of the typed plan modifier interfaces with at least one plan modifier.
+func attributeHasPlanModifiers(attribute fwschema.Attribute) bool {
+	switch a := attribute.(type) {
+	case AttributeWithBoolPlanModifiers:
+		return len(a.BoolPlanModifiers()) > 0
+	case AttributeWithFloat64PlanModifiers:
+		return len(a.Float64PlanModifiers()) > 0
+	case AttributeWithInt64PlanModifiers:
+		return len(a.Int64PlanModifiers()) > 0
+	case AttributeWithListPlanModifiers:
+		return len(a.ListPlanModifiers()) > 0
+	case AttributeWithMapPlanModifiers:
+		return len(a.MapPlanModifiers()) > 0
+	case AttributeWithNumberPlanModifiers:
+		return len(a.NumberPlanModifiers()) > 0
+	case AttributeWithObjectPlanModifiers:
+		return len(a.ObjectPlanModifiers()) > 0
+	case AttributeWithSetPlanModifiers:
+		return len(a.SetPlanModifiers()) > 0
+	case AttributeWithStringPlanModifiers:
+		return len(a.StringPlanModifiers()) > 0
+	default:
+		return false
+	}
+}
+
+// attributeHasDefaultValue returns true if the given Attribute implements any
+// of the typed default value interfaces with a non-nil default.
+func attributeHasDefaultValue(attribute fwschema.Attribute) bool {
+	switch a := attribute.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		return a.BoolDefaultValue() != nil
+	case fwschema.AttributeWithFloat64DefaultValue:
+		return a.Float64DefaultValue() != nil
+	case fwschema.AttributeWithInt64DefaultValue:
+		return a.Int64DefaultValue() != nil
+	case fwschema.AttributeWithListDefaultValue:
+		return a.ListDefaultValue() != nil
+	case fwschema.AttributeWithMapDefaultValue:
+		return a.MapDefaultValue() != nil
+	case fwschema.AttributeWithNumberDefaultValue:
+		return a.NumberDefaultValue() != nil
+	case fwschema.AttributeWithObjectDefaultValue:
+		return a.ObjectDefaultValue() != nil
+	case fwschema.AttributeWithSetDefaultValue:
+		return a.SetDefaultValue() != nil
+	case fwschema.AttributeWithStringDefaultValue:
+		return a.StringDefaultValue() != nil
+	default:
+		return false
+	}
+}
+
+// blockHasPlanModifiers returns true if the given Block implements any of the
+// typed plan modifier interfaces with at least one plan modifier.
+func blockHasPlanModifiers(block fwschema.Block) bool {
+	switch b := block.(type) {
+	case BlockWithListPlanModifiers:
+		return len(b.ListPlanModifiers()) > 0
+	case BlockWithObjectPlanModifiers:
+		return len(b.ObjectPlanModifiers()) > 0
+	case BlockWithSetPlanModifiers:
+		return len(b.SetPlanModifiers()) > 0
+	default:
+		return false
+	}
+}
@@ -30,5 +30,12 @@ func NestedAttributesEqual(a, b NestedAttribute) bool {
 		return false
 	}
 
+	aMinItems, aMaxItems := NestedAttributeItemsLimits(a)
+	bMinItems, bMaxItems := NestedAttributeItemsLimits(b)
+
+	if aMinItems != bMinItems || aMaxItems != bMaxItems {
+		return false
+	}
+
 	return a.GetNestedObject().Equal(b.GetNestedObject())
 }
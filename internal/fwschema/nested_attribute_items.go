@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// NestedAttributeWithItemsLimits is an optional interface on NestedAttribute
+// which enables enforcing the number of elements in list and set nested
+// attributes during configuration validation.
+type NestedAttributeWithItemsLimits interface {
+	NestedAttribute
+
+	// GetMaxItems should return the maximum number of elements, where zero
+	// means there is no maximum.
+	GetMaxItems() int64
+
+	// GetMinItems should return the minimum number of elements, where zero
+	// means there is no minimum.
+	GetMinItems() int64
+}
+
+// NestedAttributeItemsLimits returns the minimum and maximum number of
+// elements for the NestedAttribute. Both are zero if the NestedAttribute does
+// not implement NestedAttributeWithItemsLimits.
+func NestedAttributeItemsLimits(a NestedAttribute) (int64, int64) {
+	nestedAttributeWithItemsLimits, ok := a.(NestedAttributeWithItemsLimits)
+
+	if !ok {
+		return 0, 0
+	}
+
+	return nestedAttributeWithItemsLimits.GetMinItems(), nestedAttributeWithItemsLimits.GetMaxItems()
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaWithTerraformType is a Schema with a precomputed Terraform type. This
+// prevents converting the entire schema into its Terraform type on every use,
+// such as decoding the protocol data of every RPC for large schemas.
+type SchemaWithTerraformType interface {
+	Schema
+
+	// TerraformType returns the precomputed Terraform type of the schema.
+	TerraformType(context.Context) tftypes.Type
+}
+
+// NewSchemaWithTerraformType returns the given Schema with its Terraform type
+// precomputed. The given Schema must not be modified afterwards.
+func NewSchemaWithTerraformType(ctx context.Context, s Schema) SchemaWithTerraformType {
+	if sWithTerraformType, ok := s.(SchemaWithTerraformType); ok {
+		return sWithTerraformType
+	}
+
+	return schemaWithTerraformType{
+		Schema:        s,
+		terraformType: s.Type().TerraformType(ctx),
+	}
+}
+
+// SchemaTerraformType returns the Terraform type of the given Schema, using
+// the precomputed type if the Schema implements SchemaWithTerraformType.
+func SchemaTerraformType(ctx context.Context, s Schema) tftypes.Type {
+	if sWithTerraformType, ok := s.(SchemaWithTerraformType); ok {
+		return sWithTerraformType.TerraformType(ctx)
+	}
+
+	return s.Type().TerraformType(ctx)
+}
+
+// schemaWithTerraformType is the SchemaWithTerraformType implementation
+// returned by NewSchemaWithTerraformType. All Schema methods are handled by
+// the embedded Schema.
+type schemaWithTerraformType struct {
+	Schema
+
+	terraformType tftypes.Type
+}
+
+// TerraformType returns the precomputed Terraform type of the schema.
+func (s schemaWithTerraformType) TerraformType(_ context.Context) tftypes.Type {
+	return s.terraformType
+}
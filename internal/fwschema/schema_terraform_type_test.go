@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_attribute": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	expected := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		schema   fwschema.Schema
+		expected tftypes.Type
+	}{
+		"schema": {
+			schema:   testSchema,
+			expected: expected,
+		},
+		"schema-with-terraform-type": {
+			schema:   fwschema.NewSchemaWithTerraformType(context.Background(), testSchema),
+			expected: expected,
+		},
+		"schema-with-terraform-type-nested": {
+			schema:   fwschema.NewSchemaWithTerraformType(context.Background(), fwschema.NewSchemaWithTerraformType(context.Background(), testSchema)),
+			expected: expected,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.SchemaTerraformType(context.Background(), testCase.schema)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			// The precomputed type must match the schema itself.
+			if !testCase.schema.Type().TerraformType(context.Background()).Equal(got) {
+				t.Errorf("expected schema type %s, got %s", testCase.schema.Type().TerraformType(context.Background()), got)
+			}
+		})
+	}
+}
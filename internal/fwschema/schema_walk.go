@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// SchemaWalkAttributes calls the function for every attribute in the schema,
+// including nested attributes and attributes within blocks, with the path
+// expression matching the attribute values. Collection elements are
+// represented with expression steps matching any element, such as
+// AtAnyListIndex.
+//
+// The order is deterministic: attributes are walked before blocks, names are
+// sorted, and nested attributes are walked directly after their parent.
+func SchemaWalkAttributes(ctx context.Context, s Schema, fn func(path.Expression, Attribute)) {
+	walkObjectAttributes(ctx, path.Empty().Expression(), s.GetAttributes(), s.GetBlocks(), fn)
+}
+
+// walkObjectAttributes calls the function for the given attributes and the
+// attributes of the given blocks, recursing into nested objects.
+func walkObjectAttributes(ctx context.Context, parentExpression path.Expression, attributes map[string]Attribute, blocks map[string]Block, fn func(path.Expression, Attribute)) {
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		attributeExpression := parentExpression.AtName(name)
+
+		fn(attributeExpression, attribute)
+
+		nestedAttribute, ok := attribute.(NestedAttribute)
+
+		if !ok || nestedAttribute.GetNestedObject() == nil {
+			continue
+		}
+
+		var elementExpression path.Expression
+
+		switch nestingMode := nestedAttribute.GetNestingMode(); nestingMode {
+		case NestingModeList:
+			elementExpression = attributeExpression.AtAnyListIndex()
+		case NestingModeMap:
+			elementExpression = attributeExpression.AtAnyMapKey()
+		case NestingModeSet:
+			elementExpression = attributeExpression.AtAnySetValue()
+		case NestingModeSingle:
+			elementExpression = attributeExpression
+		default:
+			panic(fmt.Sprintf("unhandled NestingMode: %T", nestingMode))
+		}
+
+		walkObjectAttributes(ctx, elementExpression, nestedAttribute.GetNestedObject().GetAttributes(), nil, fn)
+	}
+
+	for _, name := range sortedKeys(blocks) {
+		block := blocks[name]
+		blockExpression := parentExpression.AtName(name)
+
+		if block.GetNestedObject() == nil {
+			continue
+		}
+
+		var elementExpression path.Expression
+
+		switch nestingMode := block.GetNestingMode(); nestingMode {
+		case BlockNestingModeList:
+			elementExpression = blockExpression.AtAnyListIndex()
+		case BlockNestingModeSet:
+			elementExpression = blockExpression.AtAnySetValue()
+		case BlockNestingModeSingle:
+			elementExpression = blockExpression
+		default:
+			panic(fmt.Sprintf("unhandled BlockNestingMode: %T", nestingMode))
+		}
+
+		walkObjectAttributes(ctx, elementExpression, block.GetNestedObject().GetAttributes(), block.GetNestedObject().GetBlocks(), fn)
+	}
+}
+
+// sortedKeys returns the map keys in sorted order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -5,12 +5,16 @@ package fwschemadata
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -25,6 +29,14 @@ func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) d
 		return diags
 	}
 
+	// Reading past the end of a known list is a provider logic error, rather
+	// than a missing value, so raise it instead of returning a null value.
+	diags.Append(d.listIndexOutOfRangeDiags(ctx, schemaPath)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
 	if attrValue == nil {
 		diags.AddAttributeError(
 			schemaPath,
@@ -59,3 +71,63 @@ func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) d
 
 	return diags
 }
+
+// listIndexOutOfRangeDiags returns an error diagnostic if any step of the
+// given path is a list index beyond the length of a known, non-null list.
+//
+// This is only called by GetAtPath, as internal logic such as plan
+// modification and semantic equality intentionally reads list elements which
+// may not exist in prior data and expects a null value.
+func (d Data) listIndexOutOfRangeDiags(ctx context.Context, schemaPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, schemaPath)
+
+	if tftypesPathDiags.HasError() {
+		return diags
+	}
+
+	if _, err := d.TerraformValueAtTerraformPath(ctx, tftypesPath); !errors.Is(err, tftypes.ErrInvalidStep) {
+		return diags
+	}
+
+	steps := tftypesPath.Steps()
+
+	// Find the first list index step which is out of range, so paths which
+	// continue past it, such as an attribute of a nested object, are also
+	// reported.
+	for i, step := range steps {
+		index, ok := step.(tftypes.ElementKeyInt)
+
+		if !ok {
+			continue
+		}
+
+		parentValue, err := d.TerraformValueAtTerraformPath(ctx, tftypes.NewAttributePathWithSteps(steps[:i]))
+
+		if err != nil || !parentValue.IsKnown() || parentValue.IsNull() || !parentValue.Type().Is(tftypes.List{}) {
+			return diags
+		}
+
+		var elements []tftypes.Value
+
+		if err := parentValue.As(&elements); err != nil {
+			return diags
+		}
+
+		if int64(index) >= 0 && int64(index) < int64(len(elements)) {
+			continue
+		}
+
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to retrieve an attribute value from the given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("List index %d is out of range for a list with %d elements.", int64(index), len(elements)),
+		)
+
+		return diags
+	}
+
+	return diags
+}
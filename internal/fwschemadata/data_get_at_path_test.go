@@ -96,9 +96,10 @@ func TestDataGetAtPath(t *testing.T) {
 				diag.WithPath(
 					path.Root("string"),
 					intreflect.DiagIntoIncompatibleType{
-						Val:        tftypes.NewValue(tftypes.String, "test"),
-						TargetType: reflect.TypeOf(false),
-						Err:        fmt.Errorf("can't unmarshal %s into *bool, expected boolean", tftypes.String),
+						Val:           tftypes.NewValue(tftypes.String, "test"),
+						TargetType:    reflect.TypeOf(false),
+						Err:           fmt.Errorf("can't unmarshal %s into *bool, expected boolean", tftypes.String),
+						SuggestedType: "string or testtypes.String",
 					},
 				),
 			},
@@ -352,7 +353,7 @@ func TestDataGetAtPath(t *testing.T) {
 					path.Root("bool"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: bool\nTarget Type: *bool\nSuggested Type: basetypes.BoolValue",
 				),
 			},
@@ -604,7 +605,7 @@ func TestDataGetAtPath(t *testing.T) {
 					path.Root("float64"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: float64\nTarget Type: *float64\nSuggested Type: basetypes.Float64Value",
 				),
 			},
@@ -856,7 +857,7 @@ func TestDataGetAtPath(t *testing.T) {
 					path.Root("int64"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: int64\nTarget Type: *int64\nSuggested Type: basetypes.Int64Value",
 				),
 			},
@@ -2132,6 +2133,241 @@ func TestDataGetAtPath(t *testing.T) {
 				{NestedString: types.StringValue("test2")},
 			},
 		},
+		"ListNestedAttributes-element-struct-value": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"list": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list": tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"list": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test1"),
+									},
+								),
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test2"),
+									},
+								),
+							},
+						),
+					},
+				),
+			},
+			path: path.Root("list").AtListIndex(1),
+			target: new(struct {
+				NestedString types.String `tfsdk:"nested_string"`
+			}),
+			expected: &struct {
+				NestedString types.String `tfsdk:"nested_string"`
+			}{
+				NestedString: types.StringValue("test2"),
+			},
+		},
+		"ListNestedAttributes-element-out-of-range": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"list": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list": tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"list": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test1"),
+									},
+								),
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test2"),
+									},
+								),
+							},
+						),
+					},
+				),
+			},
+			path: path.Root("list").AtListIndex(2),
+			target: new(struct {
+				NestedString types.String `tfsdk:"nested_string"`
+			}),
+			expected: &struct {
+				NestedString types.String `tfsdk:"nested_string"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list").AtListIndex(2),
+					"Data Read Error",
+					"An unexpected error was encountered trying to retrieve an attribute value from the given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List index 2 is out of range for a list with 2 elements.",
+				),
+			},
+		},
+		"ListNestedAttributes-element-out-of-range-nested": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"list": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list": tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"list": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test1"),
+									},
+								),
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test2"),
+									},
+								),
+							},
+						),
+					},
+				),
+			},
+			path:     path.Root("list").AtListIndex(5).AtName("nested_string"),
+			target:   new(types.String),
+			expected: new(types.String),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list").AtListIndex(5).AtName("nested_string"),
+					"Data Read Error",
+					"An unexpected error was encountered trying to retrieve an attribute value from the given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List index 5 is out of range for a list with 2 elements.",
+				),
+			},
+		},
 		"ListType-types.List-null": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -3632,7 +3868,7 @@ func TestDataGetAtPath(t *testing.T) {
 					path.Root("object"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: object\nTarget Type: *struct { NestedString basetypes.StringValue \"tfsdk:\\\"nested_string\\\"\" }\nSuggested Type: basetypes.ObjectValue",
 				),
 			},
@@ -4996,6 +5232,88 @@ func TestDataGetAtPath(t *testing.T) {
 				{NestedString: types.StringValue("test2")},
 			},
 		},
+		"SetNestedAttributes-element-struct-value": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"set": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSet,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"set": tftypes.Set{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"set": tftypes.NewValue(
+							tftypes.Set{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_string": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test1"),
+									},
+								),
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_string": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested_string": tftypes.NewValue(tftypes.String, "test2"),
+									},
+								),
+							},
+						),
+					},
+				),
+			},
+			path: path.Root("set").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"nested_string": types.StringType,
+				},
+				map[string]attr.Value{
+					"nested_string": types.StringValue("test2"),
+				},
+			)),
+			target: new(struct {
+				NestedString types.String `tfsdk:"nested_string"`
+			}),
+			expected: &struct {
+				NestedString types.String `tfsdk:"nested_string"`
+			}{
+				NestedString: types.StringValue("test2"),
+			},
+		},
 		"SetType-types.Set-null": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -5585,7 +5903,7 @@ func TestDataGetAtPath(t *testing.T) {
 					path.Root("object"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: object\nTarget Type: *struct { NestedString basetypes.StringValue \"tfsdk:\\\"nested_string\\\"\" }\nSuggested Type: basetypes.ObjectValue",
 				),
 			},
@@ -6057,7 +6375,7 @@ func TestDataGetAtPath(t *testing.T) {
 					path.Root("object"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: object\nTarget Type: *struct { NestedString basetypes.StringValue \"tfsdk:\\\"nested_string\\\"\" }\nSuggested Type: basetypes.ObjectValue",
 				),
 			},
@@ -6413,7 +6731,7 @@ func TestDataGetAtPath(t *testing.T) {
 					path.Root("string"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: string\nTarget Type: *string\nSuggested Type: basetypes.StringValue",
 				),
 			},
@@ -75,6 +75,7 @@ func TestDataGet(t *testing.T) {
 								"string": tftypes.String,
 							},
 						}),
+						SuggestedType: "struct or basetypes.ObjectValue",
 					},
 				),
 			},
@@ -384,7 +385,7 @@ func TestDataGet(t *testing.T) {
 					path.Root("bool"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: bool\nTarget Type: *bool\nSuggested Type: basetypes.BoolValue",
 				),
 			},
@@ -681,7 +682,7 @@ func TestDataGet(t *testing.T) {
 					path.Root("float64"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: float64\nTarget Type: *float64\nSuggested Type: basetypes.Float64Value",
 				),
 			},
@@ -978,7 +979,7 @@ func TestDataGet(t *testing.T) {
 					path.Root("int64"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: int64\nTarget Type: *int64\nSuggested Type: basetypes.Int64Value",
 				),
 			},
@@ -4030,7 +4031,7 @@ func TestDataGet(t *testing.T) {
 					path.Root("object"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: object\nTarget Type: *struct { NestedString basetypes.StringValue \"tfsdk:\\\"nested_string\\\"\" }\nSuggested Type: basetypes.ObjectValue",
 				),
 			},
@@ -6175,7 +6176,7 @@ func TestDataGet(t *testing.T) {
 					path.Root("object"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: object\nTarget Type: *struct { NestedString basetypes.StringValue \"tfsdk:\\\"nested_string\\\"\" }\nSuggested Type: basetypes.ObjectValue",
 				),
 			},
@@ -6700,7 +6701,7 @@ func TestDataGet(t *testing.T) {
 					path.Root("object"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: object\nTarget Type: *struct { NestedString basetypes.StringValue \"tfsdk:\\\"nested_string\\\"\" }\nSuggested Type: basetypes.ObjectValue",
 				),
 			},
@@ -7109,7 +7110,7 @@ func TestDataGet(t *testing.T) {
 					path.Root("string"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
 						"Path: string\nTarget Type: *string\nSuggested Type: basetypes.StringValue",
 				),
 			},
@@ -20,6 +20,12 @@ func (d *Data) NullifyCollectionBlocks(ctx context.Context) diag.Diagnostics {
 
 	blockPathExpressions := fwschema.SchemaBlockPathExpressions(ctx, d.Schema)
 
+	// Do not transform if there are no blocks. This prevents converting the
+	// path of every value, which walks the schema each time.
+	if len(blockPathExpressions) == 0 {
+		return diags
+	}
+
 	// Errors are handled as richer diag.Diagnostics instead.
 	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
 		// Do not transform if value is already null or is not fully known.
@@ -27,6 +33,14 @@ func (d *Data) NullifyCollectionBlocks(ctx context.Context) diag.Diagnostics {
 			return tfTypeValue, nil
 		}
 
+		// Do not transform if value is not a list or set, before the more
+		// expensive path conversion.
+		switch tfTypeValue.Type().(type) {
+		case tftypes.List, tftypes.Set:
+		default:
+			return tfTypeValue, nil
+		}
+
 		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
 
 		diags.Append(fwPathDiags...)
@@ -44,26 +58,21 @@ func (d *Data) NullifyCollectionBlocks(ctx context.Context) diag.Diagnostics {
 
 		var elements []tftypes.Value
 
-		switch tfTypeValue.Type().(type) {
-		case tftypes.List, tftypes.Set:
-			err := tfTypeValue.As(&elements)
-
-			// If this occurs, it likely is an upstream issue in Terraform
-			// or terraform-plugin-go.
-			if err != nil {
-				diags.AddAttributeError(
-					fwPath,
-					d.Description.Title()+" Data Transformation Error",
-					"An unexpected error occurred while transforming "+d.Description.String()+" data. "+
-						"This is always an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
-						"Path: "+fwPath.String()+"\n"+
-						"Error: (tftypes.Value).As() error: "+err.Error(),
-				)
-
-				return tfTypeValue, nil //nolint:nilerr // Using richer diag.Diagnostics instead.
-			}
-		default:
-			return tfTypeValue, nil
+		err := tfTypeValue.As(&elements)
+
+		// If this occurs, it likely is an upstream issue in Terraform
+		// or terraform-plugin-go.
+		if err != nil {
+			diags.AddAttributeError(
+				fwPath,
+				d.Description.Title()+" Data Transformation Error",
+				"An unexpected error occurred while transforming "+d.Description.String()+" data. "+
+					"This is always an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
+					"Path: "+fwPath.String()+"\n"+
+					"Error: (tftypes.Value).As() error: "+err.Error(),
+			)
+
+			return tfTypeValue, nil //nolint:nilerr // Using richer diag.Diagnostics instead.
 		}
 
 		// Do not transform if there are any elements.
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PathNullOrUnknown returns whether the value at the path is null or unknown.
+// If a parent value is null or unknown, the path cannot be reached and the
+// parent value determines the result. A path which cannot be reached in a
+// known value, such as a list index beyond the list length, is considered
+// null. An error diagnostic is returned if the path is not in the schema.
+func (d Data) PathNullOrUnknown(ctx context.Context, schemaPath path.Path) (bool, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, schemaPath)
+
+	diags.Append(tftypesPathDiags...)
+
+	if diags.HasError() {
+		return false, false, diags
+	}
+
+	_, err := d.Schema.TypeAtTerraformPath(ctx, tftypesPath)
+
+	if err != nil {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, false, diags
+	}
+
+	value, _, err := tftypes.WalkAttributePath(d.TerraformValue, tftypesPath)
+
+	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot walk attribute path in %s: %s", d.Description, err),
+		)
+
+		return false, false, diags
+	}
+
+	tfValue, ok := value.(tftypes.Value)
+
+	if !ok {
+		return true, false, diags
+	}
+
+	if !tfValue.IsKnown() {
+		return false, true, diags
+	}
+
+	// The walk stopped at a known, non-null parent value, such as a list
+	// which does not have an element at the index.
+	if err != nil {
+		return true, false, diags
+	}
+
+	return tfValue.IsNull(), false, diags
+}
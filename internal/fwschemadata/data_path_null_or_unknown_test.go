@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataPathNullOrUnknown(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"test_object": testschema.Attribute{
+				Optional: true,
+				Type: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test_nested": types.StringType,
+					},
+				},
+			},
+			"test_string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list": tftypes.List{ElementType: tftypes.String},
+			"test_object": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_nested": tftypes.String,
+				},
+			},
+			"test_string": tftypes.String,
+		},
+	}
+
+	testObjectType := testSchemaType.AttributeTypes["test_object"]
+
+	testData := func(list tftypes.Value, object tftypes.Value, str tftypes.Value) fwschemadata.Data {
+		return fwschemadata.Data{
+			Description: fwschemadata.DataDescriptionConfiguration,
+			Schema:      testSchema,
+			TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_list":   list,
+				"test_object": object,
+				"test_string": str,
+			}),
+		}
+	}
+
+	nullList := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+	nullObject := tftypes.NewValue(testObjectType, nil)
+	nullString := tftypes.NewValue(tftypes.String, nil)
+
+	testCases := map[string]struct {
+		data            fwschemadata.Data
+		path            path.Path
+		expectedNull    bool
+		expectedUnknown bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"known": {
+			data: testData(nullList, nullObject, tftypes.NewValue(tftypes.String, "test")),
+			path: path.Root("test_string"),
+		},
+		"null": {
+			data:         testData(nullList, nullObject, nullString),
+			path:         path.Root("test_string"),
+			expectedNull: true,
+		},
+		"unknown": {
+			data:            testData(nullList, nullObject, tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			path:            path.Root("test_string"),
+			expectedUnknown: true,
+		},
+		"nested-known": {
+			data: testData(nullList, tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"test_nested": tftypes.NewValue(tftypes.String, "test"),
+			}), nullString),
+			path: path.Root("test_object").AtName("test_nested"),
+		},
+		"nested-null": {
+			data: testData(nullList, tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"test_nested": tftypes.NewValue(tftypes.String, nil),
+			}), nullString),
+			path:         path.Root("test_object").AtName("test_nested"),
+			expectedNull: true,
+		},
+		"parent-null": {
+			data:         testData(nullList, nullObject, nullString),
+			path:         path.Root("test_object").AtName("test_nested"),
+			expectedNull: true,
+		},
+		"parent-unknown": {
+			data:            testData(nullList, tftypes.NewValue(testObjectType, tftypes.UnknownValue), nullString),
+			path:            path.Root("test_object").AtName("test_nested"),
+			expectedUnknown: true,
+		},
+		"list-index-known": {
+			data: testData(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test"),
+			}), nullObject, nullString),
+			path: path.Root("test_list").AtListIndex(0),
+		},
+		"list-index-out-of-range": {
+			data: testData(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test"),
+			}), nullObject, nullString),
+			path:         path.Root("test_list").AtListIndex(1),
+			expectedNull: true,
+		},
+		"invalid-path": {
+			data: testData(nullList, nullObject, nullString),
+			path: path.Root("test_missing"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_missing"),
+					"Configuration Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"test_missing\") still remains in the path: could not find attribute or block \"test_missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotNull, gotUnknown, diags := testCase.data.PathNullOrUnknown(context.Background(), testCase.path)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if gotNull != testCase.expectedNull {
+				t.Errorf("expected null %t, got %t", testCase.expectedNull, gotNull)
+			}
+
+			if gotUnknown != testCase.expectedUnknown {
+				t.Errorf("expected unknown %t, got %t", testCase.expectedUnknown, gotUnknown)
+			}
+		})
+	}
+}
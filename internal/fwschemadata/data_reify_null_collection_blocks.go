@@ -20,6 +20,12 @@ func (d *Data) ReifyNullCollectionBlocks(ctx context.Context) diag.Diagnostics {
 
 	blockPathExpressions := fwschema.SchemaBlockPathExpressions(ctx, d.Schema)
 
+	// Do not transform if there are no blocks. This prevents converting the
+	// path of every value, which walks the schema each time.
+	if len(blockPathExpressions) == 0 {
+		return diags
+	}
+
 	// Errors are handled as richer diag.Diagnostics instead.
 	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
 		// Only transform null values.
@@ -27,6 +33,14 @@ func (d *Data) ReifyNullCollectionBlocks(ctx context.Context) diag.Diagnostics {
 			return tfTypeValue, nil
 		}
 
+		// Only transform list and set values, before the more expensive path
+		// conversion.
+		switch tfTypeValue.Type().(type) {
+		case tftypes.List, tftypes.Set:
+		default:
+			return tfTypeValue, nil
+		}
+
 		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
 
 		diags.Append(fwPathDiags...)
@@ -43,16 +57,11 @@ func (d *Data) ReifyNullCollectionBlocks(ctx context.Context) diag.Diagnostics {
 		}
 
 		// Transform to empty value.
-		switch tfTypeValue.Type().(type) {
-		case tftypes.List, tftypes.Set:
-			logging.FrameworkTrace(ctx, "Transforming null block to empty block", map[string]any{
-				logging.KeyAttributePath: fwPath.String(),
-				logging.KeyDescription:   d.Description.String(),
-			})
-			return tftypes.NewValue(tfTypeValue.Type(), []tftypes.Value{}), nil
-		default:
-			return tfTypeValue, nil
-		}
+		logging.FrameworkTrace(ctx, "Transforming null block to empty block", map[string]any{
+			logging.KeyAttributePath: fwPath.String(),
+			logging.KeyDescription:   d.Description.String(),
+		})
+		return tftypes.NewValue(tfTypeValue.Type(), []tftypes.Value{}), nil
 	})
 
 	return diags
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// SortSetElements reorders the elements of every known set value, including
+// sets nested within other values, by their Terraform value representation.
+// Set elements have no meaningful order, however the encoded value otherwise
+// preserves the order the provider created the elements in, which can differ
+// between operations for the same set. Sorting ensures equal sets are always
+// encoded identically.
+func (d *Data) SortSetElements(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Do not transform if the schema has no set types. This prevents walking
+	// every value, such as for the majority of schemas without sets.
+	if d.Schema == nil || !terraformTypeMayContainSet(d.Schema.Type().TerraformType(ctx)) {
+		return diags
+	}
+
+	// Transform visits nested values before their parent value, so set
+	// elements which contain sets are already sorted when the parent set is
+	// sorted.
+	newValue, err := tftypes.Transform(d.TerraformValue, func(_ *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		// Only transform known set values.
+		if _, ok := tfTypeValue.Type().(tftypes.Set); !ok || tfTypeValue.IsNull() || !tfTypeValue.IsKnown() {
+			return tfTypeValue, nil
+		}
+
+		var elements []tftypes.Value
+
+		if err := tfTypeValue.As(&elements); err != nil {
+			return tfTypeValue, err
+		}
+
+		// Copy the elements, since the underlying slice may be shared with
+		// other values.
+		sortedElements := append([]tftypes.Value{}, elements...)
+
+		sort.SliceStable(sortedElements, func(i, j int) bool {
+			return sortedElements[i].String() < sortedElements[j].String()
+		})
+
+		return tftypes.NewValue(tfTypeValue.Type(), sortedElements), nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Sort "+d.Description.Title()+" Set Elements",
+			"An unexpected error was encountered when sorting the set elements of the "+d.Description.String()+". "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Please report this to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	d.TerraformValue = newValue
+
+	return diags
+}
+
+// terraformTypeMayContainSet returns true if the type is a set type or
+// contains a set type, such as an object attribute type. Dynamic types may
+// contain any type, including sets.
+func terraformTypeMayContainSet(typ tftypes.Type) bool {
+	switch typ := typ.(type) {
+	case tftypes.Set:
+		return true
+	case tftypes.List:
+		return terraformTypeMayContainSet(typ.ElementType)
+	case tftypes.Map:
+		return terraformTypeMayContainSet(typ.ElementType)
+	case tftypes.Object:
+		for _, attributeType := range typ.AttributeTypes {
+			if terraformTypeMayContainSet(attributeType) {
+				return true
+			}
+		}
+
+		return false
+	case tftypes.Tuple:
+		for _, elementType := range typ.ElementTypes {
+			if terraformTypeMayContainSet(elementType) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return typ.Is(tftypes.DynamicPseudoType)
+}
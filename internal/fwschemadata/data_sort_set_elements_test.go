@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataSortSetElements(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"list_attribute": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"set_attribute": testschema.Attribute{
+				Optional: true,
+				Type:     types.SetType{ElemType: types.StringType},
+			},
+			"set_nested_attribute": testschema.Attribute{
+				Optional: true,
+				Type: types.SetType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_set": types.SetType{ElemType: types.StringType},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_set": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list_attribute":       tftypes.List{ElementType: tftypes.String},
+			"set_attribute":        tftypes.Set{ElementType: tftypes.String},
+			"set_nested_attribute": tftypes.Set{ElementType: testNestedObjectType},
+		},
+	}
+
+	testCases := map[string]struct {
+		terraformValue tftypes.Value
+		expected       tftypes.Value
+		expectedDiags  diag.Diagnostics
+	}{
+		"null": {
+			terraformValue: tftypes.NewValue(testSchemaType, nil),
+			expected:       tftypes.NewValue(testSchemaType, nil),
+		},
+		"unknown-set": {
+			terraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute":       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"set_attribute":        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, nil),
+			}),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute":       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"set_attribute":        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, nil),
+			}),
+		},
+		"list-unmodified": {
+			terraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "b"),
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+				"set_attribute":        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, nil),
+			}),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "b"),
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+				"set_attribute":        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, nil),
+			}),
+		},
+		"set-sorted": {
+			terraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"set_attribute": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "c"),
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, nil),
+			}),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"set_attribute": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+					tftypes.NewValue(tftypes.String, "c"),
+				}),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, nil),
+			}),
+		},
+		"set-nested-sorted": {
+			terraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"set_attribute":  tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, []tftypes.Value{
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"nested_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "d"),
+							tftypes.NewValue(tftypes.String, "c"),
+						}),
+					}),
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"nested_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "b"),
+							tftypes.NewValue(tftypes.String, "a"),
+						}),
+					}),
+				}),
+			}),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"set_attribute":  tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"set_nested_attribute": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, []tftypes.Value{
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"nested_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "a"),
+							tftypes.NewValue(tftypes.String, "b"),
+						}),
+					}),
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"nested_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "c"),
+							tftypes.NewValue(tftypes.String, "d"),
+						}),
+					}),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testCase.terraformValue,
+			}
+
+			diags := data.SortSetElements(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataSortSetElements_noSetTypes(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"list_attribute": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	// The value is intentionally inconsistent with the schema to verify the
+	// values are not walked when the schema has no set types.
+	testValue := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list_attribute": tftypes.Set{ElementType: tftypes.String},
+		},
+	}, map[string]tftypes.Value{
+		"list_attribute": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "b"),
+			tftypes.NewValue(tftypes.String, "a"),
+		}),
+	})
+
+	data := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         testSchema,
+		TerraformValue: testValue,
+	}
+
+	diags := data.SortSetElements(context.Background())
+
+	if len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(data.TerraformValue, testValue); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TerraformValueJSON returns the JSON encoding of the Data TerraformValue,
+// which Terraform accepts as an alternative to MessagePack encoding.
+//
+// An error is returned if the value is not wholly known, since JSON encoding
+// cannot represent unknown values.
+func (d Data) TerraformValueJSON(_ context.Context) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := terraformValueJSON(&buf, d.TerraformValue, tftypes.NewAttributePath())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// terraformValueJSON writes the JSON encoding of the value to the buffer.
+func terraformValueJSON(buf *bytes.Buffer, value tftypes.Value, path *tftypes.AttributePath) error {
+	if !value.IsKnown() {
+		return path.NewErrorf("unknown values cannot be encoded as JSON")
+	}
+
+	if value.IsNull() {
+		buf.WriteString("null")
+
+		return nil
+	}
+
+	var result interface{}
+
+	switch valueType := value.Type(); {
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		return terraformValueJSONElements(buf, value, path)
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		return terraformValueJSONAttributes(buf, value, path)
+	case valueType.Is(tftypes.Bool):
+		var b bool
+
+		if err := value.As(&b); err != nil {
+			return path.NewError(err)
+		}
+
+		result = b
+	case valueType.Is(tftypes.Number):
+		var n big.Float
+
+		if err := value.As(&n); err != nil {
+			return path.NewError(err)
+		}
+
+		result = json.Number(n.Text('f', -1))
+	case valueType.Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return path.NewError(err)
+		}
+
+		result = s
+	default:
+		return path.NewErrorf("unsupported type %s", valueType)
+	}
+
+	encoded, err := json.Marshal(result)
+
+	if err != nil {
+		return path.NewError(err)
+	}
+
+	buf.Write(encoded)
+
+	return nil
+}
+
+// terraformValueJSONElements writes the JSON array encoding of a list, set, or
+// tuple value to the buffer.
+func terraformValueJSONElements(buf *bytes.Buffer, value tftypes.Value, path *tftypes.AttributePath) error {
+	var elements []tftypes.Value
+
+	if err := value.As(&elements); err != nil {
+		return path.NewError(err)
+	}
+
+	buf.WriteString("[")
+
+	for i, element := range elements {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		elementPath := path.WithElementKeyInt(i)
+
+		if value.Type().Is(tftypes.Set{}) {
+			elementPath = path.WithElementKeyValue(element)
+		}
+
+		if err := terraformValueJSON(buf, element, elementPath); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteString("]")
+
+	return nil
+}
+
+// terraformValueJSONAttributes writes the JSON object encoding of a map or
+// object value to the buffer, sorted by key for consistent output.
+func terraformValueJSONAttributes(buf *bytes.Buffer, value tftypes.Value, path *tftypes.AttributePath) error {
+	var attributes map[string]tftypes.Value
+
+	if err := value.As(&attributes); err != nil {
+		return path.NewError(err)
+	}
+
+	keys := make([]string, 0, len(attributes))
+
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	buf.WriteString("{")
+
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		encodedKey, err := json.Marshal(key)
+
+		if err != nil {
+			return path.NewError(err)
+		}
+
+		buf.Write(encodedKey)
+		buf.WriteString(":")
+
+		attributePath := path.WithAttributeName(key)
+
+		if value.Type().Is(tftypes.Map{}) {
+			attributePath = path.WithElementKeyString(key)
+		}
+
+		if err := terraformValueJSON(buf, attributes[key], attributePath); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteString("}")
+
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataTerraformValueJSON(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_bool": testschema.Attribute{
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"test_list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"test_map": testschema.Attribute{
+				Optional: true,
+				Type:     types.MapType{ElemType: types.NumberType},
+			},
+			"test_object": testschema.Attribute{
+				Optional: true,
+				Type: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test_nested": types.StringType,
+					},
+				},
+			},
+			"test_set": testschema.Attribute{
+				Optional: true,
+				Type:     types.SetType{ElemType: types.BoolType},
+			},
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_bool": tftypes.Bool,
+			"test_list": tftypes.List{ElementType: tftypes.String},
+			"test_map":  tftypes.Map{ElementType: tftypes.Number},
+			"test_object": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_nested": tftypes.String,
+				},
+			},
+			"test_set": tftypes.Set{ElementType: tftypes.Bool},
+		},
+	}
+
+	testObjectType := testSchemaType.AttributeTypes["test_object"]
+
+	testCases := map[string]struct {
+		terraformValue tftypes.Value
+		expected       []byte
+		expectedError  error
+	}{
+		"null": {
+			terraformValue: tftypes.NewValue(testSchemaType, nil),
+			expected:       []byte(`null`),
+		},
+		"null-attributes": {
+			terraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_bool":   tftypes.NewValue(tftypes.Bool, nil),
+				"test_list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"test_map":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, nil),
+				"test_object": tftypes.NewValue(testObjectType, nil),
+				"test_set":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.Bool}, nil),
+			}),
+			expected: []byte(`{"test_bool":null,"test_list":null,"test_map":null,"test_object":null,"test_set":null}`),
+		},
+		"values": {
+			terraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_bool": tftypes.NewValue(tftypes.Bool, true),
+				"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, `"quoted"`),
+					tftypes.NewValue(tftypes.String, nil),
+				}),
+				"test_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+					"b": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+					"a": tftypes.NewValue(tftypes.Number, big.NewFloat(-10)),
+				}),
+				"test_object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"test_nested": tftypes.NewValue(tftypes.String, "nested"),
+				}),
+				"test_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Bool}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Bool, false),
+				}),
+			}),
+			expected: []byte(`{"test_bool":true,"test_list":["\"quoted\"",null],"test_map":{"a":-10,"b":1.5},"test_object":{"test_nested":"nested"},"test_set":[false]}`),
+		},
+		"unknown": {
+			terraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_bool": tftypes.NewValue(tftypes.Bool, nil),
+				"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				"test_map":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, nil),
+				"test_object": tftypes.NewValue(testObjectType, nil),
+				"test_set":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.Bool}, nil),
+			}),
+			expectedError: errors.New(`AttributeName("test_list").ElementKeyInt(0): unknown values cannot be encoded as JSON`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testCase.terraformValue,
+			}
+
+			got, err := data.TerraformValueJSON(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(string(got), string(testCase.expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			roundTrip, err := tftypes.ValueFromJSON(got, testSchemaType)
+
+			if err != nil {
+				t.Fatalf("unexpected error decoding JSON: %s", err)
+			}
+
+			if !roundTrip.Equal(testCase.terraformValue) {
+				t.Errorf("expected round trip value %s, got: %s", testCase.terraformValue, roundTrip)
+			}
+		})
+	}
+}
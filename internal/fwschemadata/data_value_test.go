@@ -152,6 +152,42 @@ func TestDataValueAtPath(t *testing.T) {
 			path:     path.Root("test").AtListIndex(0),
 			expected: types.StringValue("value"),
 		},
+		"WithAttributeName-List-WithElementKeyInt-out-of-range": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{
+							ElementType: tftypes.String,
+						},
+						"other": tftypes.Bool,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.List{
+						ElementType: tftypes.String,
+					}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "value"),
+						tftypes.NewValue(tftypes.String, "othervalue"),
+					}),
+					"other": tftypes.NewValue(tftypes.Bool, nil),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: types.ListType{
+								ElemType: types.StringType,
+							},
+							Required: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.BoolType,
+							Optional: true,
+						},
+					},
+				},
+			},
+			path:     path.Root("test").AtListIndex(2),
+			expected: types.StringNull(),
+		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
@@ -74,6 +74,10 @@ type ModifyAttributePlanResponse struct {
 	Diagnostics     diag.Diagnostics
 	RequiresReplace path.Paths
 	Private         *privatestate.ProviderData
+
+	// Plan is the planned new state for the resource, including any changes
+	// to other attributes made by plan modifiers.
+	Plan tfsdk.Plan
 }
 
 // AttributeModifyPlan runs all AttributePlanModifiers
@@ -89,6 +93,26 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 		resp.Private = req.Private
 	}
 
+	// Sensitive values must not be leaked into practitioner facing
+	// diagnostics or logs by provider defined plan modifiers, so mask any
+	// occurrences of the values which are introduced during plan
+	// modification, including of nested attributes.
+	if fwschema.AttributeIsLogSensitive(a) {
+		sensitiveStrings := sensitiveValueStrings(ctx, req.AttributeConfig, req.AttributePlan, req.AttributeState)
+
+		if len(sensitiveStrings) > 0 {
+			ctx = logging.MaskStrings(ctx, sensitiveStrings...)
+			diagsIndex := len(resp.Diagnostics)
+
+			defer func() {
+				resp.Diagnostics = append(
+					resp.Diagnostics[:diagsIndex],
+					redactSensitiveDiagnostics(resp.Diagnostics[diagsIndex:], sensitiveStrings)...,
+				)
+			}()
+		}
+	}
+
 	switch attributeWithPlanModifiers := a.(type) {
 	case fwxschema.AttributeWithBoolPlanModifiers:
 		AttributePlanModifyBool(ctx, attributeWithPlanModifiers, req, resp)
@@ -229,6 +253,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				StateValue:     stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
+				Plan:          resp.Plan,
 				AttributePlan: objectReq.PlanValue,
 				Private:       objectReq.Private,
 			}
@@ -257,6 +282,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			planElements[idx] = respValuable
 			resp.Diagnostics.Append(objectResp.Diagnostics...)
 			resp.Private = objectResp.Private
+			resp.Plan = objectResp.Plan
 			resp.RequiresReplace.Append(objectResp.RequiresReplace...)
 		}
 
@@ -380,6 +406,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				StateValue:     stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
+				Plan:          resp.Plan,
 				AttributePlan: objectReq.PlanValue,
 				Private:       objectReq.Private,
 			}
@@ -408,6 +435,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			planElements[idx] = respValuable
 			resp.Diagnostics.Append(objectResp.Diagnostics...)
 			resp.Private = objectResp.Private
+			resp.Plan = objectResp.Plan
 			resp.RequiresReplace.Append(objectResp.RequiresReplace...)
 		}
 
@@ -531,6 +559,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				StateValue:     stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
+				Plan:          resp.Plan,
 				AttributePlan: objectReq.PlanValue,
 				Private:       objectReq.Private,
 			}
@@ -559,6 +588,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			planElements[key] = respValuable
 			resp.Diagnostics.Append(objectResp.Diagnostics...)
 			resp.Private = objectResp.Private
+			resp.Plan = objectResp.Plan
 			resp.RequiresReplace.Append(objectResp.RequiresReplace...)
 		}
 
@@ -637,6 +667,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			StateValue:     stateObject,
 		}
 		objectResp := &ModifyAttributePlanResponse{
+			Plan:          resp.Plan,
 			AttributePlan: objectReq.PlanValue,
 			Private:       objectReq.Private,
 		}
@@ -645,6 +676,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 
 		resp.Diagnostics.Append(objectResp.Diagnostics...)
 		resp.Private = objectResp.Private
+		resp.Plan = objectResp.Plan
 		resp.RequiresReplace.Append(objectResp.RequiresReplace...)
 
 		respValue, diags := coerceObjectValue(ctx, req.AttributePath, objectResp.AttributePlan)
@@ -786,6 +818,7 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.BoolResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -813,6 +846,7 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -946,6 +980,7 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Float64Response{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -973,6 +1008,7 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -1106,6 +1142,7 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Int64Response{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -1133,6 +1170,7 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -1266,6 +1304,7 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ListResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -1293,6 +1332,7 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -1426,6 +1466,7 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.MapResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -1453,6 +1494,7 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -1586,6 +1628,7 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.NumberResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -1613,6 +1656,7 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -1746,6 +1790,7 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ObjectResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -1773,6 +1818,7 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -1906,6 +1952,7 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.SetResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -1933,6 +1980,7 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -2066,6 +2114,7 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.StringResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -2093,6 +2142,7 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -2125,6 +2175,7 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 			// Instantiate a new response for each request to prevent plan modifiers
 			// from modifying or removing diagnostics.
 			planModifyResp := &planmodifier.ObjectResponse{
+				Plan:      resp.Plan,
 				PlanValue: req.PlanValue,
 				Private:   resp.Private,
 			}
@@ -2151,6 +2202,7 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 			resp.AttributePlan = planModifyResp.PlanValue
 			resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 			resp.Private = planModifyResp.Private
+			resp.Plan = planModifyResp.Plan
 
 			if planModifyResp.RequiresReplace {
 				resp.RequiresReplace.Append(req.Path)
@@ -2202,6 +2254,7 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 			State:                   req.State,
 		}
 		nestedAttrResp := &ModifyAttributePlanResponse{
+			Plan:            resp.Plan,
 			AttributePlan:   nestedAttrReq.AttributePlan,
 			RequiresReplace: resp.RequiresReplace,
 			Private:         nestedAttrReq.Private,
@@ -2212,6 +2265,7 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 		newPlanValueAttributes[nestedName] = nestedAttrResp.AttributePlan
 		resp.Diagnostics.Append(nestedAttrResp.Diagnostics...)
 		resp.Private = nestedAttrResp.Private
+		resp.Plan = nestedAttrResp.Plan
 		resp.RequiresReplace.Append(nestedAttrResp.RequiresReplace...)
 	}
 
@@ -94,6 +94,39 @@ func TestAttributeModifyPlan(t *testing.T) {
 				AttributePlan: types.StringValue("MODIFIED_TWO"),
 			},
 		},
+		"attribute-sensitive-diagnostics-redacted": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				Required:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddAttributeError(
+								req.Path,
+								"Invalid Value",
+								"Cannot change "+req.StateValue.ValueString()+" to "+req.PlanValue.ValueString()+".",
+							)
+						},
+					},
+				},
+			},
+			req: ModifyAttributePlanRequest{
+				AttributeConfig: types.StringValue("newsecret"),
+				AttributePath:   path.Root("test"),
+				AttributePlan:   types.StringValue("newsecret"),
+				AttributeState:  types.StringValue("oldsecret"),
+			},
+			expectedResp: ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("newsecret"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Value",
+						"Cannot change (sensitive value) to (sensitive value).",
+					),
+				},
+			},
+		},
 		"attribute-request-private": {
 			attribute: testschema.AttributeWithStringPlanModifiers{
 				Required: true,
@@ -765,6 +798,151 @@ func TestAttributeModifyPlan(t *testing.T) {
 				},
 			},
 		},
+		"attribute-list-nested-nested-usestateforunknown": {
+			attribute: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_computed": testschema.AttributeWithStringPlanModifiers{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"nested_required": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+				Required:    true,
+			},
+			req: ModifyAttributePlanRequest{
+				AttributeConfig: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringNull(),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringNull(),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+					},
+				),
+				AttributePath: path.Root("test"),
+				AttributePlan: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringUnknown(),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringUnknown(),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+					},
+				),
+				AttributeState: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue1"),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue2"),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+					},
+				),
+			},
+			expectedResp: ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue1"),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue2"),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+					},
+				),
+			},
+		},
 		"attribute-list-nested-nested-usestateforunknown-elements-rearranged": {
 			attribute: testschema.NestedAttribute{
 				NestedObject: testschema.NestedAttributeObject{
@@ -9465,6 +9643,66 @@ func TestAttributePlanModifyString(t *testing.T) {
 				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
+		"request-config-sibling": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							var other types.String
+
+							resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("other"), &other)...)
+
+							if resp.Diagnostics.HasError() {
+								return
+							}
+
+							if other.ValueString() == "default" {
+								resp.PlanValue = types.StringValue("defaultvalue")
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringUnknown(),
+				AttributeState:  types.StringNull(),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other": tftypes.String,
+								"test":  tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"other": tftypes.NewValue(tftypes.String, "default"),
+							"test":  tftypes.NewValue(tftypes.String, nil),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"other": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+							"test": testschema.Attribute{
+								Computed: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("defaultvalue"),
+			},
+		},
 		"request-configvalue": {
 			attribute: testschema.AttributeWithStringPlanModifiers{
 				PlanModifiers: []planmodifier.String{
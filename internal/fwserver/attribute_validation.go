@@ -108,6 +108,43 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 
 	req.AttributeConfig = attributeConfig
 
+	// Sensitive values must not be leaked into practitioner facing
+	// diagnostics or logs by provider defined validators, so mask any
+	// occurrences of the value which are introduced during validation.
+	var sensitiveStrings []string
+
+	if fwschema.AttributeIsLogSensitive(a) {
+		sensitiveStrings = sensitiveValueStrings(ctx, attributeConfig)
+		ctx = logging.MaskStrings(ctx, sensitiveStrings...)
+	}
+
+	validatorsDiagsIndex := len(resp.Diagnostics)
+
+	AttributeValidateValidators(ctx, a, req, resp)
+
+	AttributeValidateNestedAttributes(ctx, a, req, resp)
+
+	if len(sensitiveStrings) > 0 {
+		resp.Diagnostics = append(
+			resp.Diagnostics[:validatorsDiagsIndex],
+			redactSensitiveDiagnostics(resp.Diagnostics[validatorsDiagsIndex:], sensitiveStrings)...,
+		)
+	}
+
+	// Show deprecation warnings only for known values.
+	if a.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
+		resp.Diagnostics.AddAttributeWarning(
+			req.AttributePath,
+			"Attribute Deprecated",
+			a.GetDeprecationMessage(),
+		)
+	}
+}
+
+// AttributeValidateValidators calls the provider-defined validators of the
+// attribute, such as validator.String, with the AttributeConfig value. Nested
+// attributes are not validated.
+func AttributeValidateValidators(ctx context.Context, a fwschema.Attribute, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	switch attributeWithValidators := a.(type) {
 	case fwxschema.AttributeWithBoolValidators:
 		AttributeValidateBool(ctx, attributeWithValidators, req, resp)
@@ -128,17 +165,6 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	case fwxschema.AttributeWithStringValidators:
 		AttributeValidateString(ctx, attributeWithValidators, req, resp)
 	}
-
-	AttributeValidateNestedAttributes(ctx, a, req, resp)
-
-	// Show deprecation warnings only for known values.
-	if a.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
-		resp.Diagnostics.AddAttributeWarning(
-			req.AttributePath,
-			"Attribute Deprecated",
-			a.GetDeprecationMessage(),
-		)
-	}
 }
 
 // AttributeValidateBool performs all types.Bool validation.
@@ -764,6 +790,10 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
+		if !l.IsNull() && !l.IsUnknown() {
+			nestedAttributeValidateItems(nestedAttribute, len(l.Elements()), req, resp)
+		}
+
 		for idx, value := range l.Elements() {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
 				AttributeConfig:         value,
@@ -798,6 +828,12 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
+		// Unknown set elements may later be equal to other elements, which
+		// would reduce the number of elements, so defer the check.
+		if !s.IsNull() && !s.IsUnknown() && !setHasUnknownElements(s) {
+			nestedAttributeValidateItems(nestedAttribute, len(s.Elements()), req, resp)
+		}
+
 		for _, value := range s.Elements() {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
 				AttributeConfig:         value,
@@ -893,6 +929,39 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 	}
 }
 
+// nestedAttributeValidateItems returns an error diagnostic if the number of
+// elements is outside the limits of a list or set nested attribute.
+func nestedAttributeValidateItems(a fwschema.NestedAttribute, elements int, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	minItems, maxItems := fwschema.NestedAttributeItemsLimits(a)
+
+	if minItems > 0 && int64(elements) < minItems {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s must contain at least %d elements, got: %d", req.AttributePath, minItems, elements),
+		)
+	}
+
+	if maxItems > 0 && int64(elements) > maxItems {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s must contain at most %d elements, got: %d", req.AttributePath, maxItems, elements),
+		)
+	}
+}
+
+// setHasUnknownElements returns true if any element of the set is unknown.
+func setHasUnknownElements(s basetypes.SetValue) bool {
+	for _, element := range s.Elements() {
+		if element.IsUnknown() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttributeObject, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	objectWithValidators, ok := o.(fwxschema.NestedAttributeObjectWithValidators)
 
@@ -512,6 +512,150 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"sensitive-redacted": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "supersecret"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.AttributeWithStringValidators{
+								Required:  true,
+								Sensitive: true,
+								Validators: []validator.String{
+									testvalidator.String{
+										ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+											resp.Diagnostics.AddAttributeError(
+												req.Path,
+												"Invalid Value",
+												"Value "+req.ConfigValue.ValueString()+" is not allowed.",
+											)
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Value",
+						"Value (sensitive value) is not allowed.",
+					),
+				},
+			},
+		},
+		"log-sensitive-redacted": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "supersecret"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.AttributeWithStringValidators{
+								Required:     true,
+								LogSensitive: true,
+								Validators: []validator.String{
+									testvalidator.String{
+										ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+											resp.Diagnostics.AddAttributeError(
+												req.Path,
+												"Invalid Value",
+												"Value "+req.ConfigValue.ValueString()+" is not allowed.",
+											)
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Value",
+						"Value (sensitive value) is not allowed.",
+					),
+				},
+			},
+		},
+		"sensitive-redacted-nested": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attr": tftypes.String,
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"nested_attr": tftypes.String,
+							},
+						}, map[string]tftypes.Value{
+							"nested_attr": tftypes.NewValue(tftypes.String, "supersecret"),
+						}),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.AttributeWithStringValidators{
+											Required: true,
+											Validators: []validator.String{
+												testvalidator.String{
+													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+														resp.Diagnostics.AddAttributeWarning(
+															req.Path,
+															"Unusual Value",
+															"Value "+req.ConfigValue.ValueString()+" is unusual.",
+														)
+													},
+												},
+											},
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeSingle,
+								Required:    true,
+								Sensitive:   true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test").AtName("nested_attr"),
+						"Unusual Value",
+						"Value (sensitive value) is unusual.",
+					),
+				},
+			},
+		},
 		"type-with-validate-error": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -2405,6 +2549,64 @@ func TestAttributeValidateList(t *testing.T) {
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
+		"response-diagnostics-element-path": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					testvalidator.List{
+						ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+							elementValidator := testvalidator.String{
+								ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+									if len(req.ConfigValue.ValueString()) < 3 {
+										resp.Diagnostics.AddAttributeError(
+											req.Path,
+											"Invalid String Length",
+											fmt.Sprintf("string length must be at least 3, got: %d", len(req.ConfigValue.ValueString())),
+										)
+									}
+								},
+							}
+
+							for idx, element := range req.ConfigValue.Elements() {
+								elementReq := validator.StringRequest{
+									Path:           req.Path.AtListIndex(idx),
+									PathExpression: req.PathExpression.AtListIndex(idx),
+									ConfigValue:    element.(types.String),
+									Config:         req.Config,
+								}
+								elementResp := &validator.StringResponse{}
+
+								elementValidator.ValidateString(ctx, elementReq, elementResp)
+
+								resp.Diagnostics.Append(elementResp.Diagnostics...)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("first"),
+						types.StringValue("ab"),
+						types.StringValue("third"),
+					},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(1),
+						"Invalid String Length",
+						"string length must be at least 3, got: 2",
+					),
+				},
+			},
+		},
 		"response-diagnostics": {
 			attribute: testschema.AttributeWithListValidators{
 				ElementType: types.StringType,
@@ -3861,3 +4063,179 @@ var (
 		"This is a warning.",
 	)
 )
+
+func TestAttributeValidateNestedAttributesItems(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_attr": tftypes.String,
+		},
+	}
+
+	objectValue := func(value any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"nested_attr": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+
+	testConfig := func(nestingMode fwschema.NestingMode, value any) tfsdk.Config {
+		var collectionType tftypes.Type = tftypes.List{ElementType: objectType}
+
+		if nestingMode == fwschema.NestingModeSet {
+			collectionType = tftypes.Set{ElementType: objectType}
+		}
+
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": collectionType,
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(collectionType, value),
+				},
+			),
+			Schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.NestedAttribute{
+						MaxItems: 2,
+						MinItems: 2,
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"nested_attr": testschema.Attribute{
+									Type:     types.StringType,
+									Optional: true,
+								},
+							},
+						},
+						NestingMode: nestingMode,
+						Optional:    true,
+					},
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		expected ValidateAttributeResponse
+	}{
+		"list-null": {
+			config:   testConfig(fwschema.NestingModeList, nil),
+			expected: ValidateAttributeResponse{},
+		},
+		"list-unknown": {
+			config:   testConfig(fwschema.NestingModeList, tftypes.UnknownValue),
+			expected: ValidateAttributeResponse{},
+		},
+		"list-under-min": {
+			config: testConfig(fwschema.NestingModeList, []tftypes.Value{
+				objectValue("one"),
+			}),
+			expected: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+		"list-within-limits": {
+			config: testConfig(fwschema.NestingModeList, []tftypes.Value{
+				objectValue("one"),
+				objectValue("two"),
+			}),
+			expected: ValidateAttributeResponse{},
+		},
+		"list-over-max": {
+			config: testConfig(fwschema.NestingModeList, []tftypes.Value{
+				objectValue("one"),
+				objectValue("two"),
+				objectValue("three"),
+			}),
+			expected: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test must contain at most 2 elements, got: 3",
+					),
+				},
+			},
+		},
+		"set-unknown": {
+			config:   testConfig(fwschema.NestingModeSet, tftypes.UnknownValue),
+			expected: ValidateAttributeResponse{},
+		},
+		"set-unknown-element": {
+			config: testConfig(fwschema.NestingModeSet, []tftypes.Value{
+				objectValue("one"),
+				objectValue("two"),
+				tftypes.NewValue(objectType, tftypes.UnknownValue),
+			}),
+			expected: ValidateAttributeResponse{},
+		},
+		"set-under-min": {
+			config: testConfig(fwschema.NestingModeSet, []tftypes.Value{
+				objectValue("one"),
+			}),
+			expected: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test must contain at least 2 elements, got: 1",
+					),
+				},
+			},
+		},
+		"set-over-max": {
+			config: testConfig(fwschema.NestingModeSet, []tftypes.Value{
+				objectValue("one"),
+				objectValue("two"),
+				objectValue("three"),
+			}),
+			expected: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test must contain at most 2 elements, got: 3",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config:        testCase.config,
+			}
+
+			attribute, diags := req.Config.Schema.AttributeAtPath(ctx, req.AttributePath)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			var got ValidateAttributeResponse
+
+			AttributeValidate(ctx, attribute, req, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
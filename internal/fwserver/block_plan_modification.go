@@ -149,6 +149,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 				StateValue:     stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
+				Plan:          resp.Plan,
 				AttributePlan: objectReq.PlanValue,
 				Private:       objectReq.Private,
 			}
@@ -177,6 +178,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 			planElements[idx] = respValuable
 			resp.Diagnostics.Append(objectResp.Diagnostics...)
 			resp.Private = objectResp.Private
+			resp.Plan = objectResp.Plan
 			resp.RequiresReplace.Append(objectResp.RequiresReplace...)
 		}
 
@@ -300,6 +302,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 				StateValue:     stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
+				Plan:          resp.Plan,
 				AttributePlan: objectReq.PlanValue,
 				Private:       objectReq.Private,
 			}
@@ -328,6 +331,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 			planElements[idx] = respValuable
 			resp.Diagnostics.Append(objectResp.Diagnostics...)
 			resp.Private = objectResp.Private
+			resp.Plan = objectResp.Plan
 			resp.RequiresReplace.Append(objectResp.RequiresReplace...)
 		}
 
@@ -406,6 +410,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 			StateValue:     stateObject,
 		}
 		objectResp := &ModifyAttributePlanResponse{
+			Plan:          resp.Plan,
 			AttributePlan: objectReq.PlanValue,
 			Private:       objectReq.Private,
 		}
@@ -414,6 +419,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 
 		resp.Diagnostics.Append(objectResp.Diagnostics...)
 		resp.Private = objectResp.Private
+		resp.Plan = objectResp.Plan
 		resp.RequiresReplace.Append(objectResp.RequiresReplace...)
 
 		respValue, diags := coerceObjectValue(ctx, req.AttributePath, objectResp.AttributePlan)
@@ -555,6 +561,7 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ListResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -582,6 +589,7 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -715,6 +723,7 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ObjectResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -742,6 +751,7 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -875,6 +885,7 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.SetResponse{
+			Plan:      resp.Plan,
 			PlanValue: planModifyReq.PlanValue,
 			Private:   resp.Private,
 		}
@@ -902,6 +913,7 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 
 		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 		resp.Private = planModifyResp.Private
+		resp.Plan = planModifyResp.Plan
 
 		if planModifyResp.RequiresReplace {
 			resp.RequiresReplace.Append(req.AttributePath)
@@ -934,6 +946,7 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 			// Instantiate a new response for each request to prevent plan modifiers
 			// from modifying or removing diagnostics.
 			planModifyResp := &planmodifier.ObjectResponse{
+				Plan:      resp.Plan,
 				PlanValue: req.PlanValue,
 				Private:   resp.Private,
 			}
@@ -960,6 +973,7 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 			resp.AttributePlan = planModifyResp.PlanValue
 			resp.Diagnostics.Append(planModifyResp.Diagnostics...)
 			resp.Private = planModifyResp.Private
+			resp.Plan = planModifyResp.Plan
 
 			if planModifyResp.RequiresReplace {
 				resp.RequiresReplace.Append(req.Path)
@@ -1011,6 +1025,7 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 			State:                   req.State,
 		}
 		nestedAttrResp := &ModifyAttributePlanResponse{
+			Plan:            resp.Plan,
 			AttributePlan:   nestedAttrReq.AttributePlan,
 			RequiresReplace: resp.RequiresReplace,
 			Private:         nestedAttrReq.Private,
@@ -1021,6 +1036,7 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 		newPlanValueAttributes[nestedName] = nestedAttrResp.AttributePlan
 		resp.Diagnostics.Append(nestedAttrResp.Diagnostics...)
 		resp.Private = nestedAttrResp.Private
+		resp.Plan = nestedAttrResp.Plan
 		resp.RequiresReplace.Append(nestedAttrResp.RequiresReplace...)
 	}
 
@@ -1061,6 +1077,7 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 			State:                   req.State,
 		}
 		nestedBlockResp := &ModifyAttributePlanResponse{
+			Plan:            resp.Plan,
 			AttributePlan:   nestedBlockReq.AttributePlan,
 			RequiresReplace: resp.RequiresReplace,
 			Private:         nestedBlockReq.Private,
@@ -1071,6 +1088,7 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 		newPlanValueAttributes[nestedName] = nestedBlockResp.AttributePlan
 		resp.Diagnostics.Append(nestedBlockResp.Diagnostics...)
 		resp.Private = nestedBlockResp.Private
+		resp.Plan = nestedBlockResp.Plan
 		resp.RequiresReplace.Append(nestedBlockResp.RequiresReplace...)
 	}
 
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// dataSourceKnownConfigDiags returns an error diagnostic for every unknown
+// configuration value matching the path expressions, which the data source
+// declared must be known before Read is called. Path expressions matching
+// within an unknown parent value report the parent path.
+func dataSourceKnownConfigDiags(ctx context.Context, expressions path.Expressions, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var unknownPaths path.Paths
+
+	data := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         config.Schema,
+		TerraformValue: config.Raw,
+	}
+
+	for _, expression := range expressions {
+		matchedPaths, matchedPathsDiags := data.PathMatches(ctx, expression)
+
+		diags.Append(matchedPathsDiags...)
+
+		if matchedPathsDiags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			_, unknown, unknownDiags := data.PathNullOrUnknown(ctx, matchedPath)
+
+			diags.Append(unknownDiags...)
+
+			if !unknown || unknownPaths.Contains(matchedPath) {
+				continue
+			}
+
+			unknownPaths = append(unknownPaths, matchedPath)
+		}
+	}
+
+	for _, unknownPath := range unknownPaths {
+		diags.AddAttributeError(
+			unknownPath,
+			"Unknown Data Source Configuration Value",
+			"The data source cannot be read while this configuration value is unknown. "+
+				"Terraform typically waits to read data sources until their configuration is known. "+
+				"If this value depends on changes to other resources, apply those changes first and then try again.",
+		)
+	}
+
+	return diags
+}
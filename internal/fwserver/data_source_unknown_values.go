@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// dataSourceUnknownValueDiags returns an error diagnostic for every unknown
+// value in the data source state after the Read method. Data sources have no
+// planning phase, so Terraform rejects any unknown values, however that error
+// does not include which attribute was unknown.
+func dataSourceUnknownValueDiags(ctx context.Context, schema fwschema.Schema, state tftypes.Value) diag.Diagnostics {
+	return unknownValueDiags(
+		ctx,
+		schema,
+		state,
+		"Invalid Data Source State",
+		"The Terraform Provider returned an unknown value in the data source state after Read. "+
+			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+			"Data sources have no plan, so all state values must be known after Read. "+
+			"Set the attribute to a known value, or to a null value if it cannot be determined.",
+	)
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// sensitiveValueMask is the text which replaces sensitive values found within
+// diagnostic summaries and details.
+const sensitiveValueMask = "(sensitive value)"
+
+// sensitiveValueStrings returns all known, non-empty string values contained
+// within the given values, including those nested in collections and objects.
+// Only strings are considered as masking number or boolean representations,
+// such as 1 or true, would mangle unrelated diagnostic text.
+//
+// The result is deduplicated and sorted longest first, so a sensitive value
+// which contains another sensitive value is fully masked.
+func sensitiveValueStrings(ctx context.Context, values ...attr.Value) []string {
+	var result []string
+
+	for _, value := range values {
+		if value == nil || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			continue
+		}
+
+		_ = tftypes.Walk(tfValue, func(_ *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+			if !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() {
+				return true, nil
+			}
+
+			var s string
+
+			if err := v.As(&s); err != nil || s == "" {
+				return true, nil
+			}
+
+			result = append(result, s)
+
+			return true, nil
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i]) != len(result[j]) {
+			return len(result[i]) > len(result[j])
+		}
+
+		return result[i] < result[j]
+	})
+
+	deduplicated := result[:1]
+
+	for _, s := range result[1:] {
+		if s != deduplicated[len(deduplicated)-1] {
+			deduplicated = append(deduplicated, s)
+		}
+	}
+
+	return deduplicated
+}
+
+// schemaSensitiveValueStrings returns the sensitiveValueStrings of every
+// attribute in the schema which should be masked in logs and diagnostics, for
+// each of the given values. This is used for provider defined logic which
+// operates on the entire resource, such as ModifyPlan.
+func schemaSensitiveValueStrings(ctx context.Context, schema fwschema.Schema, values ...tftypes.Value) []string {
+	var expressions path.Expressions
+
+	fwschema.SchemaWalkAttributes(ctx, schema, func(expression path.Expression, a fwschema.Attribute) {
+		if fwschema.AttributeIsLogSensitive(a) {
+			expressions.Append(expression)
+		}
+	})
+
+	if len(expressions) == 0 {
+		return nil
+	}
+
+	var attributeValues []attr.Value
+
+	for _, value := range values {
+		data := fwschemadata.Data{
+			Schema:         schema,
+			TerraformValue: value,
+		}
+
+		for _, expression := range expressions {
+			paths, diags := data.PathMatches(ctx, expression)
+
+			if diags.HasError() {
+				continue
+			}
+
+			for _, p := range paths {
+				attributeValue, diags := data.ValueAtPath(ctx, p)
+
+				if diags.HasError() {
+					continue
+				}
+
+				attributeValues = append(attributeValues, attributeValue)
+			}
+		}
+	}
+
+	return sensitiveValueStrings(ctx, attributeValues...)
+}
+
+// redactSensitiveDiagnostics returns the given diagnostics with all whole
+// occurrences of the given strings masked in the summary and detail.
+// Diagnostics which do not contain any of the strings are returned unchanged.
+//
+// Diagnostics created by the diag package functions are recreated with the
+// same type, severity, and path. Any other diagnostic type is wrapped, so the
+// original diagnostic remains available via an Unwrap() diag.Diagnostic
+// method.
+func redactSensitiveDiagnostics(diags diag.Diagnostics, sensitiveStrings []string) diag.Diagnostics {
+	if len(diags) == 0 || len(sensitiveStrings) == 0 {
+		return diags
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		summary := redactSensitiveStrings(d.Summary(), sensitiveStrings)
+		detail := redactSensitiveStrings(d.Detail(), sensitiveStrings)
+
+		if summary == d.Summary() && detail == d.Detail() {
+			result = append(result, d)
+
+			continue
+		}
+
+		result = append(result, redactSensitiveDiagnostic(d, summary, detail))
+	}
+
+	return result
+}
+
+// redactSensitiveDiagnostic returns the diagnostic with the given summary and
+// detail, preserving the diagnostic type where possible.
+func redactSensitiveDiagnostic(d diag.Diagnostic, summary string, detail string) diag.Diagnostic {
+	var original, redacted diag.Diagnostic
+
+	switch d.Severity() {
+	case diag.SeverityWarning:
+		original = diag.NewWarningDiagnostic(d.Summary(), d.Detail())
+		redacted = diag.NewWarningDiagnostic(summary, detail)
+	default:
+		original = diag.NewErrorDiagnostic(d.Summary(), d.Detail())
+		redacted = diag.NewErrorDiagnostic(summary, detail)
+	}
+
+	dWithPath, ok := d.(diag.DiagnosticWithPath)
+
+	if ok {
+		original = diag.WithPath(dWithPath.Path(), original)
+		redacted = diag.WithPath(dWithPath.Path(), redacted)
+	}
+
+	// The diag package diagnostic types require the other diagnostic to be
+	// the same type for equality.
+	if original.Equal(d) {
+		return redacted
+	}
+
+	redacted = sensitiveDiagnostic{
+		Diagnostic: d,
+		summary:    summary,
+		detail:     detail,
+	}
+
+	if ok {
+		redacted = diag.WithPath(dWithPath.Path(), redacted)
+	}
+
+	return redacted
+}
+
+// redactSensitiveStrings replaces all whole occurrences of the given strings
+// in the input with sensitiveValueMask.
+func redactSensitiveStrings(in string, sensitiveStrings []string) string {
+	for _, s := range sensitiveStrings {
+		in = redactSensitiveString(in, s)
+	}
+
+	return in
+}
+
+// redactSensitiveString replaces all occurrences of the string in the input
+// with sensitiveValueMask, unless the occurrence is only part of a longer
+// word, such as the value "secret" within "secretive".
+func redactSensitiveString(in string, s string) string {
+	if s == "" || !strings.Contains(in, s) {
+		return in
+	}
+
+	first, _ := utf8.DecodeRuneInString(s)
+	last, _ := utf8.DecodeLastRuneInString(s)
+
+	var result strings.Builder
+
+	start := 0
+
+	for {
+		index := strings.Index(in[start:], s)
+
+		if index < 0 {
+			break
+		}
+
+		index += start
+		end := index + len(s)
+
+		before, _ := utf8.DecodeLastRuneInString(in[:index])
+		after, _ := utf8.DecodeRuneInString(in[end:])
+
+		// Only mask the whole value, which cannot continue a word.
+		if (sensitiveValueWordRune(first) && sensitiveValueWordRune(before)) || (sensitiveValueWordRune(last) && sensitiveValueWordRune(after)) {
+			result.WriteString(in[start:end])
+		} else {
+			result.WriteString(in[start:index])
+			result.WriteString(sensitiveValueMask)
+		}
+
+		start = end
+	}
+
+	result.WriteString(in[start:])
+
+	return result.String()
+}
+
+// sensitiveValueWordRune returns true if the rune can be part of a word.
+func sensitiveValueWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// sensitiveDiagnostic wraps a provider defined diagnostic type to mask
+// sensitive values in its summary and detail.
+type sensitiveDiagnostic struct {
+	diag.Diagnostic
+
+	summary string
+	detail  string
+}
+
+// Detail returns the masked diagnostic detail.
+func (d sensitiveDiagnostic) Detail() string {
+	return d.detail
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d sensitiveDiagnostic) Equal(other diag.Diagnostic) bool {
+	o, ok := other.(sensitiveDiagnostic)
+
+	if !ok {
+		return false
+	}
+
+	return d.summary == o.summary && d.detail == o.detail && d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// Summary returns the masked diagnostic summary.
+func (d sensitiveDiagnostic) Summary() string {
+	return d.summary
+}
+
+// Unwrap returns the original diagnostic.
+func (d sensitiveDiagnostic) Unwrap() diag.Diagnostic {
+	return d.Diagnostic
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSensitiveValueStrings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected []string
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"bool": {
+			value:    types.BoolValue(true),
+			expected: nil,
+		},
+		"int64": {
+			value:    types.Int64Value(1),
+			expected: nil,
+		},
+		"string-null": {
+			value:    types.StringNull(),
+			expected: nil,
+		},
+		"string-unknown": {
+			value:    types.StringUnknown(),
+			expected: nil,
+		},
+		"string-empty": {
+			value:    types.StringValue(""),
+			expected: nil,
+		},
+		"string": {
+			value:    types.StringValue("secret"),
+			expected: []string{"secret"},
+		},
+		"string-short": {
+			value:    types.StringValue("1234"),
+			expected: []string{"1234"},
+		},
+		"list": {
+			value: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("a"),
+					types.StringNull(),
+					types.StringValue("secret"),
+					types.StringValue("supersecret"),
+					types.StringValue("secret"),
+				},
+			),
+			expected: []string{"supersecret", "secret", "a"},
+		},
+		"object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"bool_attr":   types.BoolType,
+					"string_attr": types.StringType,
+				},
+				map[string]attr.Value{
+					"bool_attr":   types.BoolValue(true),
+					"string_attr": types.StringValue("secret"),
+				},
+			),
+			expected: []string{"secret"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := sensitiveValueStrings(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRedactSensitiveDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags            diag.Diagnostics
+		sensitiveStrings []string
+		expected         diag.Diagnostics
+	}{
+		"nil": {
+			diags:            nil,
+			sensitiveStrings: []string{"secret"},
+			expected:         nil,
+		},
+		"no-sensitive-strings": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary secret", "detail secret"),
+			},
+			sensitiveStrings: nil,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary secret", "detail secret"),
+			},
+		},
+		"unchanged": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary", "detail"),
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary", "detail"),
+			},
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary secret", "detail secret secret"),
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary (sensitive value)", "detail (sensitive value) (sensitive value)"),
+			},
+		},
+		"warning": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("summary", "detail secret"),
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("summary", "detail (sensitive value)"),
+			},
+		},
+		"attribute-error": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "summary", "detail secret"),
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "summary", "detail (sensitive value)"),
+			},
+		},
+		"attribute-warning": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "summary", "detail secret"),
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "summary", "detail (sensitive value)"),
+			},
+		},
+		"partial-word": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary", "secretive secrets are \"secret\"."),
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary", "secretive secrets are \"(sensitive value)\"."),
+			},
+		},
+		"short-value": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary", "PIN 1234 was rejected, expected 4 digits."),
+			},
+			sensitiveStrings: []string{"1234"},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("summary", "PIN (sensitive value) was rejected, expected 4 digits."),
+			},
+		},
+		"custom": {
+			diags: diag.Diagnostics{
+				testCustomDiagnostic{detail: "detail secret"},
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				sensitiveDiagnostic{
+					Diagnostic: testCustomDiagnostic{detail: "detail secret"},
+					summary:    "custom summary",
+					detail:     "detail (sensitive value)",
+				},
+			},
+		},
+		"custom-attribute": {
+			diags: diag.Diagnostics{
+				diag.WithPath(path.Root("test"), testCustomDiagnostic{detail: "detail secret"}),
+			},
+			sensitiveStrings: []string{"secret"},
+			expected: diag.Diagnostics{
+				diag.WithPath(
+					path.Root("test"),
+					sensitiveDiagnostic{
+						Diagnostic: diag.WithPath(path.Root("test"), testCustomDiagnostic{detail: "detail secret"}),
+						summary:    "custom summary",
+						detail:     "detail (sensitive value)",
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := redactSensitiveDiagnostics(testCase.diags, testCase.sensitiveStrings)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testCustomDiagnostic is a provider defined diagnostic type.
+type testCustomDiagnostic struct {
+	detail string
+}
+
+func (d testCustomDiagnostic) Detail() string {
+	return d.detail
+}
+
+func (d testCustomDiagnostic) Equal(other diag.Diagnostic) bool {
+	o, ok := other.(testCustomDiagnostic)
+
+	return ok && d.detail == o.detail
+}
+
+func (d testCustomDiagnostic) Severity() diag.Severity {
+	return diag.SeverityError
+}
+
+func (d testCustomDiagnostic) Summary() string {
+	return "custom summary"
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// NewStateUnknownValues returns an error diagnostic for every unknown value in
+// the new state after the Create or Update methods. Terraform rejects unknown
+// values after apply, however that error does not include which attributes
+// were not set, which is typically a Computed attribute the provider logic
+// never set after it was marked unknown in the plan.
+func NewStateUnknownValues(ctx context.Context, schema fwschema.Schema, newState tftypes.Value) diag.Diagnostics {
+	return unknownValueDiags(
+		ctx,
+		schema,
+		newState,
+		"Provider Returned Unknown Value After Apply",
+		"The Terraform Provider returned an unknown value in the resource state after apply. "+
+			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+			"Computed attributes which are unknown in the plan must be set to a known value, which can be null, "+
+			"in the resource state before the Create or Update method returns.",
+	)
+}
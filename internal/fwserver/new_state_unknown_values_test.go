@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewStateUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_list":     tftypes.List{ElementType: tftypes.String},
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testValue := func(computed tftypes.Value, list tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": computed,
+			"test_list":     list,
+			"test_required": tftypes.NewValue(tftypes.String, "test"),
+		})
+	}
+
+	testDetail := "The Terraform Provider returned an unknown value in the resource state after apply. " +
+		"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+		"Computed attributes which are unknown in the plan must be set to a known value, which can be null, " +
+		"in the resource state before the Create or Update method returns."
+
+	nullString := tftypes.NewValue(tftypes.String, nil)
+	nullList := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+
+	testCases := map[string]struct {
+		newState      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			newState: tftypes.NewValue(testSchemaType, nil),
+		},
+		"known": {
+			newState: testValue(tftypes.NewValue(tftypes.String, "test"), nullList),
+		},
+		"null-computed": {
+			newState: testValue(nullString, nullList),
+		},
+		"unknown-computed": {
+			newState: testValue(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nullList),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_computed"),
+					"Provider Returned Unknown Value After Apply",
+					testDetail,
+				),
+			},
+		},
+		"unknown-list": {
+			newState: testValue(nullString, tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Provider Returned Unknown Value After Apply",
+					testDetail,
+				),
+			},
+		},
+		"unknown-list-element": {
+			newState: testValue(nullString, tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list").AtListIndex(1),
+					"Provider Returned Unknown Value After Apply",
+					testDetail,
+				),
+			},
+		},
+		"multiple-ordered": {
+			newState: testValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_computed"),
+					"Provider Returned Unknown Value After Apply",
+					testDetail,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Provider Returned Unknown Value After Apply",
+					testDetail,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.NewStateUnknownValues(context.Background(), testSchema, testCase.newState)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// EnvTfPluginFrameworkPanicStack is an environment variable which, when set
+// to any non-empty value, includes a truncated stack trace in the error
+// diagnostic returned for a recovered panic. The stack trace is always
+// logged, so this is opt-in to prevent practitioner output noise.
+const EnvTfPluginFrameworkPanicStack = "TF_PLUGIN_FRAMEWORK_PANIC_STACK"
+
+// panicStackMaxLength is the maximum number of bytes of the stack trace
+// included in a recovered panic diagnostic.
+const panicStackMaxLength = 4096
+
+// RecoverPanic recovers a panic, such as in provider-defined logic, and
+// appends an error diagnostic including the resource, data source, or
+// function name, or the RPC name for provider-level logic, so the RPC can
+// return normally and the provider process continues serving
+// requests. It must be called directly with defer.
+func RecoverPanic(ctx context.Context, typeName string, diags *diag.Diagnostics) {
+	r := recover()
+
+	if r == nil {
+		return
+	}
+
+	stack := string(debug.Stack())
+
+	logging.FrameworkError(
+		ctx,
+		"Recovered from panic",
+		map[string]interface{}{
+			logging.KeyError: fmt.Sprintf("%v", r),
+			"stack":          stack,
+		},
+	)
+
+	detail := "The provider panicked while handling the " + typeName + " request. " +
+		"This is always an issue in the provider and should be reported to the provider developers.\n\n" +
+		fmt.Sprintf("Panic: %v", r)
+
+	if os.Getenv(EnvTfPluginFrameworkPanicStack) != "" {
+		if len(stack) > panicStackMaxLength {
+			stack = stack[:panicStackMaxLength] + "\n..."
+		}
+
+		detail += "\n\nStack Trace:\n" + stack
+	}
+
+	diags.AddError("Provider Panic", detail)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+)
+
+func TestRecoverPanic(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	func() {
+		defer fwserver.RecoverPanic(context.Background(), "test_resource", &diags)
+
+		panic("test panic")
+	}()
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Provider Panic",
+			"The provider panicked while handling the test_resource request. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Panic: test panic",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRecoverPanic_noPanic(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	func() {
+		defer fwserver.RecoverPanic(context.Background(), "test_resource", &diags)
+	}()
+
+	if len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+//nolint:paralleltest // Environment variables are process-wide.
+func TestRecoverPanic_stack(t *testing.T) {
+	t.Setenv(fwserver.EnvTfPluginFrameworkPanicStack, "1")
+
+	var diags diag.Diagnostics
+
+	func() {
+		defer fwserver.RecoverPanic(context.Background(), "test_resource", &diags)
+
+		panic("test panic")
+	}()
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %v", diags)
+	}
+
+	detail := diags[0].Detail()
+
+	if !strings.Contains(detail, "Stack Trace:\n") {
+		t.Errorf("expected stack trace in detail, got: %s", detail)
+	}
+
+	if !strings.Contains(detail, "TestRecoverPanic_stack") {
+		t.Errorf("expected panicking function in stack trace, got: %s", detail)
+	}
+}
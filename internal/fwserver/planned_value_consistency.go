@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// PlannedValueConsistency returns an error diagnostic for every known planned
+// value which does not match the new state value at the same path after the
+// Create or Update methods. Terraform rejects these responses as inconsistent
+// results after apply, however that error does not include the differing
+// values, so this raises the issue with actionable details beforehand.
+//
+// Unknown planned values are skipped since any value is valid for them. Set
+// values are compared as a whole, since elements are identified by value.
+// A null planned value for the entire resource is also skipped. Null and
+// empty list or set blocks are considered equal.
+func PlannedValueConsistency(ctx context.Context, schema fwschema.Schema, planned tftypes.Value, newState tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A null plan indicates resource destruction, which has no new state.
+	if planned.IsNull() {
+		return diags
+	}
+
+	// The request plan has empty list and set blocks converted to null
+	// values, while the response converts them back to empty values, so
+	// both are normalized to prevent false positives. Terraform itself does
+	// not differentiate null and empty collection blocks.
+	plannedData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionPlan,
+		Schema:         schema,
+		TerraformValue: planned.Copy(),
+	}
+
+	diags.Append(plannedData.ReifyNullCollectionBlocks(ctx)...)
+
+	newStateData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         schema,
+		TerraformValue: newState.Copy(),
+	}
+
+	diags.Append(newStateData.ReifyNullCollectionBlocks(ctx)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	plannedValueConsistencyWalk(ctx, schema, tftypes.NewAttributePath(), plannedData.TerraformValue, newStateData.TerraformValue, &diags)
+
+	return diags
+}
+
+// plannedValueConsistencyWalk compares the planned and new state values at the
+// given path, recursing into objects, lists, maps, and tuples with matching
+// structure so differences are reported on the most precise path. Object
+// attributes and map keys are visited in sorted order so diagnostics are
+// deterministic.
+func plannedValueConsistencyWalk(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath, plannedValue tftypes.Value, newStateValue tftypes.Value, diags *diag.Diagnostics) {
+	if !plannedValue.IsKnown() || plannedValue.Equal(newStateValue) {
+		return
+	}
+
+	if plannedValue.IsNull() || !newStateValue.IsKnown() || newStateValue.IsNull() {
+		diags.Append(plannedValueInconsistentDiag(ctx, schema, tfPath, plannedValue, newStateValue))
+
+		return
+	}
+
+	plannedType := plannedValue.Type()
+
+	switch {
+	case plannedType.Is(tftypes.Object{}), plannedType.Is(tftypes.Map{}):
+		var plannedElements, newStateElements map[string]tftypes.Value
+
+		if plannedValue.As(&plannedElements) != nil || newStateValue.As(&newStateElements) != nil || !plannedValueConsistencySameKeys(plannedElements, newStateElements) {
+			break
+		}
+
+		keys := make([]string, 0, len(plannedElements))
+
+		for key := range plannedElements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			elementPath := tfPath.WithElementKeyString(key)
+
+			if plannedType.Is(tftypes.Object{}) {
+				elementPath = tfPath.WithAttributeName(key)
+			}
+
+			plannedValueConsistencyWalk(ctx, schema, elementPath, plannedElements[key], newStateElements[key], diags)
+		}
+
+		return
+	case plannedType.Is(tftypes.List{}), plannedType.Is(tftypes.Tuple{}):
+		var plannedElements, newStateElements []tftypes.Value
+
+		if plannedValue.As(&plannedElements) != nil || newStateValue.As(&newStateElements) != nil || len(plannedElements) != len(newStateElements) {
+			break
+		}
+
+		for index := range plannedElements {
+			plannedValueConsistencyWalk(ctx, schema, tfPath.WithElementKeyInt(index), plannedElements[index], newStateElements[index], diags)
+		}
+
+		return
+	}
+
+	// Partially unknown values, such as sets with unknown elements, which
+	// cannot be compared more precisely may be validly changed during apply.
+	if !plannedValue.IsFullyKnown() {
+		return
+	}
+
+	diags.Append(plannedValueInconsistentDiag(ctx, schema, tfPath, plannedValue, newStateValue))
+}
+
+// plannedValueConsistencySameKeys returns true if both maps contain the same
+// keys.
+func plannedValueConsistencySameKeys(planned map[string]tftypes.Value, newState map[string]tftypes.Value) bool {
+	if len(planned) != len(newState) {
+		return false
+	}
+
+	for key := range planned {
+		if _, ok := newState[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// plannedValueInconsistentDiag returns the error diagnostic for a planned
+// value which does not match the new state value. Values of sensitive
+// attributes are masked.
+func plannedValueInconsistentDiag(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath, plannedValue tftypes.Value, newStateValue tftypes.Value) diag.Diagnostic {
+	plannedString := plannedValueConsistencyString(ctx, schema, tfPath, plannedValue)
+	newStateString := plannedValueConsistencyString(ctx, schema, tfPath, newStateValue)
+
+	if plannedValueConsistencySensitive(ctx, schema, tfPath) {
+		plannedString = sensitiveValueMask
+		newStateString = sensitiveValueMask
+	}
+
+	summary := "Provider Produced Inconsistent Result"
+	detail := "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+		"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+		"Known planned values must be saved into the resource state unchanged. " +
+		"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+		"such as removing any plan modifier or default which sets the value.\n\n" +
+		fmt.Sprintf("Planned Value: %s\nNew State Value: %s", plannedString, newStateString)
+
+	attributePath, diags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+	if diags.HasError() {
+		logging.FrameworkDebug(ctx, "Unable to convert planned value path for consistency diagnostic", map[string]interface{}{
+			logging.KeyError: diags.Errors(),
+		})
+
+		return diag.NewErrorDiagnostic(summary, detail+fmt.Sprintf("\nPath: %s", tfPath))
+	}
+
+	return diag.NewAttributeErrorDiagnostic(attributePath, summary, detail)
+}
+
+// plannedValueConsistencyString returns the framework string representation
+// of the value, falling back to the terraform-plugin-go representation.
+func plannedValueConsistencyString(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath, value tftypes.Value) string {
+	attrType, err := schema.TypeAtTerraformPath(ctx, tfPath)
+
+	if err != nil {
+		return value.String()
+	}
+
+	attrValue, err := attrType.ValueFromTerraform(ctx, value)
+
+	if err != nil {
+		return value.String()
+	}
+
+	return attrValue.String()
+}
+
+// plannedValueConsistencySensitive returns true if the attribute at the path,
+// any of its parent attributes, or any attribute nested underneath it, is
+// sensitive.
+func plannedValueConsistencySensitive(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath) bool {
+	steps := tfPath.Steps()
+
+	for i := len(steps); i > 0; i-- {
+		attribute, err := schema.AttributeAtTerraformPath(ctx, tftypes.NewAttributePathWithSteps(steps[:i]))
+
+		if err != nil {
+			continue
+		}
+
+		if fwschema.AttributeIsLogSensitive(attribute) {
+			return true
+		}
+	}
+
+	// Values of objects and collections, including blocks and the entire
+	// resource, contain the values of any sensitive nested attributes.
+	attributePath, diags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+	if diags.HasError() {
+		return false
+	}
+
+	var sensitive bool
+
+	fwschema.SchemaWalkAttributes(ctx, schema, func(expression path.Expression, attribute fwschema.Attribute) {
+		if fwschema.AttributeIsLogSensitive(attribute) && expression.MatchesParent(attributePath) {
+			sensitive = true
+		}
+	})
+
+	return sensitive
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlannedValueConsistency(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_list":     tftypes.List{ElementType: tftypes.String},
+			"test_password": tftypes.String,
+			"test_set":      tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"test_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"test_set": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+
+	testValue := func(computed tftypes.Value, list tftypes.Value, password tftypes.Value, set tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": computed,
+			"test_list":     list,
+			"test_password": password,
+			"test_set":      set,
+		})
+	}
+
+	testList := func(elements ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(elements))
+
+		for _, element := range elements {
+			values = append(values, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+
+	testDetail := func(planned string, newState string) string {
+		return "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+			"Known planned values must be saved into the resource state unchanged. " +
+			"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+			"such as removing any plan modifier or default which sets the value.\n\n" +
+			"Planned Value: " + planned + "\nNew State Value: " + newState
+	}
+
+	nullString := tftypes.NewValue(tftypes.String, nil)
+	nullList := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+	nullSet := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+
+	testCases := map[string]struct {
+		planned       tftypes.Value
+		newState      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null-plan": {
+			planned:  tftypes.NewValue(testSchemaType, nil),
+			newState: testValue(tftypes.NewValue(tftypes.String, "test"), nullList, nullString, nullSet),
+		},
+		"equal": {
+			planned:  testValue(tftypes.NewValue(tftypes.String, "test"), testList("a"), nullString, nullSet),
+			newState: testValue(tftypes.NewValue(tftypes.String, "test"), testList("a"), nullString, nullSet),
+		},
+		"unknown-planned": {
+			planned:  testValue(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nullList, nullString, nullSet),
+			newState: testValue(tftypes.NewValue(tftypes.String, "test"), nullList, nullString, nullSet),
+		},
+		"known-planned-changed": {
+			planned:  testValue(tftypes.NewValue(tftypes.String, "planned"), nullList, nullString, nullSet),
+			newState: testValue(tftypes.NewValue(tftypes.String, "applied"), nullList, nullString, nullSet),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_computed"),
+					"Provider Produced Inconsistent Result",
+					testDetail(`"planned"`, `"applied"`),
+				),
+			},
+		},
+		"known-planned-null": {
+			planned:  testValue(nullString, nullList, nullString, nullSet),
+			newState: testValue(tftypes.NewValue(tftypes.String, "applied"), nullList, nullString, nullSet),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_computed"),
+					"Provider Produced Inconsistent Result",
+					testDetail(`<null>`, `"applied"`),
+				),
+			},
+		},
+		"list-element-changed": {
+			planned:  testValue(nullString, testList("a", "b"), nullString, nullSet),
+			newState: testValue(nullString, testList("a", "c"), nullString, nullSet),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list").AtListIndex(1),
+					"Provider Produced Inconsistent Result",
+					testDetail(`"b"`, `"c"`),
+				),
+			},
+		},
+		"list-length-changed": {
+			planned:  testValue(nullString, testList("a"), nullString, nullSet),
+			newState: testValue(nullString, testList("a", "b"), nullString, nullSet),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Provider Produced Inconsistent Result",
+					testDetail(`["a"]`, `["a","b"]`),
+				),
+			},
+		},
+		"set-partially-unknown": {
+			planned: testValue(nullString, nullList, nullString, tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})),
+			newState: testValue(nullString, nullList, nullString, tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			})),
+		},
+		"sensitive-masked": {
+			planned:  testValue(nullString, nullList, tftypes.NewValue(tftypes.String, "planned-secret"), nullSet),
+			newState: testValue(nullString, nullList, tftypes.NewValue(tftypes.String, "applied-secret"), nullSet),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_password"),
+					"Provider Produced Inconsistent Result",
+					testDetail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+		"multiple-ordered": {
+			planned:  testValue(tftypes.NewValue(tftypes.String, "planned"), testList("a"), tftypes.NewValue(tftypes.String, "planned-secret"), nullSet),
+			newState: testValue(tftypes.NewValue(tftypes.String, "applied"), testList("b"), tftypes.NewValue(tftypes.String, "applied-secret"), nullSet),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_computed"),
+					"Provider Produced Inconsistent Result",
+					testDetail(`"planned"`, `"applied"`),
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list").AtListIndex(0),
+					"Provider Produced Inconsistent Result",
+					testDetail(`"a"`, `"b"`),
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_password"),
+					"Provider Produced Inconsistent Result",
+					testDetail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.PlannedValueConsistency(context.Background(), testSchema, testCase.planned, testCase.newState)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlannedValueConsistency_sensitiveNestedAttribute(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_name":     tftypes.String,
+			"test_password": tftypes.String,
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_object": testObjectType,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_object": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_name": schema.StringAttribute{
+						Optional: true,
+					},
+					"test_password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	testValue := func(object tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_object": object,
+		})
+	}
+
+	testObject := func(name string, password string) tftypes.Value {
+		return tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+			"test_name":     tftypes.NewValue(tftypes.String, name),
+			"test_password": tftypes.NewValue(tftypes.String, password),
+		})
+	}
+
+	testDetail := func(planned string, newState string) string {
+		return "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+			"Known planned values must be saved into the resource state unchanged. " +
+			"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+			"such as removing any plan modifier or default which sets the value.\n\n" +
+			"Planned Value: " + planned + "\nNew State Value: " + newState
+	}
+
+	testCases := map[string]struct {
+		planned       tftypes.Value
+		newState      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nested-attribute-changed": {
+			planned:  testValue(testObject("planned", "secret")),
+			newState: testValue(testObject("applied", "secret")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_object").AtName("test_name"),
+					"Provider Produced Inconsistent Result",
+					testDetail(`"planned"`, `"applied"`),
+				),
+			},
+		},
+		"parent-object-null-masked": {
+			planned:  testValue(testObject("planned", "secret")),
+			newState: testValue(tftypes.NewValue(testObjectType, nil)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_object"),
+					"Provider Produced Inconsistent Result",
+					testDetail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+		"resource-null-masked": {
+			planned:  testValue(testObject("planned", "secret")),
+			newState: tftypes.NewValue(testSchemaType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Provider Produced Inconsistent Result",
+					testDetail("(sensitive value)", "(sensitive value)"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.PlannedValueConsistency(context.Background(), testSchema, testCase.planned, testCase.newState)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
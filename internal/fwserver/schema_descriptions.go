@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// logMissingSchemaDescriptions logs a warning for all attributes and blocks
+// without descriptions in the GetProviderSchema response schemas. Missing
+// descriptions are valid, so these are only logged for provider developers
+// and documentation tooling rather than returned as diagnostics to
+// practitioners.
+func logMissingSchemaDescriptions(ctx context.Context, resp *GetProviderSchemaResponse) {
+	logging.FrameworkDebug(ctx, "Validating schema descriptions")
+
+	if resp.Provider != nil {
+		for _, p := range fwschema.SchemaMissingDescriptionPaths(ctx, resp.Provider) {
+			logging.FrameworkWarn(ctx, "Missing provider schema description", map[string]interface{}{logging.KeyAttributePath: p.String()})
+		}
+	}
+
+	if resp.ProviderMeta != nil {
+		for _, p := range fwschema.SchemaMissingDescriptionPaths(ctx, resp.ProviderMeta) {
+			logging.FrameworkWarn(ctx, "Missing provider_meta schema description", map[string]interface{}{logging.KeyAttributePath: p.String()})
+		}
+	}
+
+	for _, typeName := range sortedSchemaTypeNames(resp.ResourceSchemas) {
+		for _, p := range fwschema.SchemaMissingDescriptionPaths(ctx, resp.ResourceSchemas[typeName]) {
+			logging.FrameworkWarn(ctx, "Missing resource schema description", map[string]interface{}{
+				logging.KeyAttributePath: p.String(),
+				logging.KeyResourceType:  typeName,
+			})
+		}
+	}
+
+	for _, typeName := range sortedSchemaTypeNames(resp.DataSourceSchemas) {
+		for _, p := range fwschema.SchemaMissingDescriptionPaths(ctx, resp.DataSourceSchemas[typeName]) {
+			logging.FrameworkWarn(ctx, "Missing data source schema description", map[string]interface{}{
+				logging.KeyAttributePath:  p.String(),
+				logging.KeyDataSourceType: typeName,
+			})
+		}
+	}
+}
+
+// sortedSchemaTypeNames returns the type names of the schemas in sorted order
+// for deterministic logging.
+func sortedSchemaTypeNames(schemas map[string]fwschema.Schema) []string {
+	typeNames := make([]string, 0, len(schemas))
+
+	for typeName := range schemas {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	return typeNames
+}
@@ -5,8 +5,13 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -54,7 +59,11 @@ type ModifySchemaPlanResponse struct {
 }
 
 // SchemaModifyPlan runs all AttributePlanModifiers in all schema attributes
-// and blocks.
+// and blocks. Top level attributes are modified in lexical order of their
+// names, followed by top level blocks in lexical order of their names, so
+// changes to other attributes in the plan by plan modifiers are consistently
+// available to later plan modifiers. Only the planned values of computed
+// attributes can be changed in this manner.
 //
 // TODO: Clean up this abstraction back into an internal Schema type method.
 // The extra Schema parameter is a carry-over of creating the proto6server
@@ -69,24 +78,28 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		TerraformValue: req.Config.Raw,
 	}
 
-	planData := &fwschemadata.Data{
-		Description:    fwschemadata.DataDescriptionPlan,
-		Schema:         req.Plan.Schema,
-		TerraformValue: req.Plan.Raw,
-	}
-
 	stateData := &fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionState,
 		Schema:         req.State.Schema,
 		TerraformValue: req.State.Raw,
 	}
 
-	for name, attribute := range s.GetAttributes() {
+	attributes := s.GetAttributes()
+	attributeNames := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		attributeNames = append(attributeNames, name)
+	}
+
+	sort.Strings(attributeNames)
+
+	for _, name := range attributeNames {
+		attribute := attributes[name]
 		attrReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
-			Plan:          req.Plan,
+			Plan:          resp.Plan,
 			ProviderMeta:  req.ProviderMeta,
 			Private:       req.Private,
 		}
@@ -99,7 +112,9 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			return
 		}
 
-		attrReq.AttributePlan, diags = planData.ValueAtPath(ctx, attrReq.AttributePath)
+		// The plan value is read from the response so any changes to this
+		// attribute by plan modifiers of other attributes are included.
+		attrReq.AttributePlan, diags = resp.Plan.GetAttributeValue(ctx, attrReq.AttributePath)
 
 		resp.Diagnostics.Append(diags...)
 
@@ -117,6 +132,7 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 
 		attrResp := ModifyAttributePlanResponse{
 			AttributePlan: attrReq.AttributePlan,
+			Plan:          resp.Plan,
 			Private:       attrReq.Private,
 		}
 
@@ -128,6 +144,14 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			return
 		}
 
+		resp.Diagnostics.Append(planModificationNonComputedDiags(ctx, s, attrReq.AttributePath, resp.Plan, attrResp.Plan)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Plan = attrResp.Plan
+
 		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrReq.AttributePath, attrResp.AttributePlan)...)
 
 		if resp.Diagnostics.HasError() {
@@ -138,12 +162,22 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		resp.Private = attrResp.Private
 	}
 
-	for name, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+	blockNames := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		blockNames = append(blockNames, name)
+	}
+
+	sort.Strings(blockNames)
+
+	for _, name := range blockNames {
+		block := blocks[name]
 		blockReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
-			Plan:          req.Plan,
+			Plan:          resp.Plan,
 			ProviderMeta:  req.ProviderMeta,
 			Private:       req.Private,
 		}
@@ -156,7 +190,9 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			return
 		}
 
-		blockReq.AttributePlan, diags = planData.ValueAtPath(ctx, blockReq.AttributePath)
+		// The plan value is read from the response so any changes to this
+		// attribute by plan modifiers of other attributes are included.
+		blockReq.AttributePlan, diags = resp.Plan.GetAttributeValue(ctx, blockReq.AttributePath)
 
 		resp.Diagnostics.Append(diags...)
 
@@ -174,6 +210,7 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 
 		blockResp := ModifyAttributePlanResponse{
 			AttributePlan: blockReq.AttributePlan,
+			Plan:          resp.Plan,
 			Private:       blockReq.Private,
 		}
 
@@ -185,6 +222,14 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			return
 		}
 
+		resp.Diagnostics.Append(planModificationNonComputedDiags(ctx, s, blockReq.AttributePath, resp.Plan, blockResp.Plan)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Plan = blockResp.Plan
+
 		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, blockReq.AttributePath, blockResp.AttributePlan)...)
 
 		if resp.Diagnostics.HasError() {
@@ -195,3 +240,78 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		resp.Private = blockResp.Private
 	}
 }
+
+// planModificationNonComputedDiags returns an error diagnostic for each change
+// between the plans outside the top level attribute or block being modified,
+// which is not within a computed attribute. Terraform only permits providers
+// to modify the planned values of computed attributes, so these changes would
+// otherwise cause a confusing Terraform error.
+func planModificationNonComputedDiags(ctx context.Context, s fwschema.Schema, modifiedPath path.Path, before tfsdk.Plan, after tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if before.Raw.Equal(after.Raw) {
+		return diags
+	}
+
+	valueDiffs, err := before.Raw.Diff(after.Raw)
+
+	if err != nil {
+		diags.AddAttributeError(
+			modifiedPath,
+			"Invalid Plan Modification",
+			"An unexpected error was encountered while verifying plan modifications. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	modifiedStep := tftypes.AttributeName(modifiedPath.String())
+
+	for _, valueDiff := range valueDiffs {
+		steps := valueDiff.Path.Steps()
+
+		// Changes to the attribute or block being modified are overwritten
+		// with its own planned value.
+		if len(steps) > 0 && steps[0] == modifiedStep {
+			continue
+		}
+
+		if planModificationPathIsComputed(ctx, s, valueDiff.Path) {
+			continue
+		}
+
+		changedPath, changedPathDiags := fromtftypes.AttributePath(ctx, valueDiff.Path, s)
+
+		if changedPathDiags.HasError() {
+			changedPath = path.Empty()
+		}
+
+		diags.AddAttributeError(
+			modifiedPath,
+			"Invalid Plan Modification",
+			fmt.Sprintf("The plan modifiers of %s changed the planned value of %s, which is not a computed attribute. ", modifiedPath, changedPath)+
+				"Only the planned values of computed attributes can be changed. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+	}
+
+	return diags
+}
+
+// planModificationPathIsComputed returns true if the nearest attribute
+// containing the path is computed.
+func planModificationPathIsComputed(ctx context.Context, s fwschema.Schema, p *tftypes.AttributePath) bool {
+	for len(p.Steps()) > 0 {
+		attribute, err := fwschema.SchemaAttributeAtTerraformPath(ctx, s, p)
+
+		if err == nil {
+			return attribute.IsComputed()
+		}
+
+		p = p.WithoutLastStep()
+	}
+
+	return false
+}
@@ -32,6 +32,40 @@ func TestSchemaModifyPlan(t *testing.T) {
 
 	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
 
+	// The second plan modifier of test_a observes the change to test_b by the
+	// first plan modifier of test_a.
+	testSchemaPlanModifierOrder := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_a": testschema.AttributeWithStringPlanModifiers{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("first"))...)
+						},
+					},
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							var testB types.String
+
+							resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("test_b"), &testB)...)
+
+							if resp.Diagnostics.HasError() {
+								return
+							}
+
+							resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue(testB.ValueString()+"-second"))...)
+						},
+					},
+				},
+			},
+			"test_b": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
 	testCases := map[string]struct {
 		req          ModifySchemaPlanRequest
 		expectedResp ModifySchemaPlanResponse
@@ -1872,6 +1906,333 @@ func TestSchemaModifyPlan(t *testing.T) {
 				Private: testProviderData,
 			},
 		},
+		"attribute-response-plan-order": {
+			req: ModifySchemaPlanRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-test-b-value")
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-test-b-value")
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-test-b-value")
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResp: ModifySchemaPlanResponse{
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, "test-a-value-test-b-value"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-test-b-value")
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"attribute-response-plan-modifier-order": {
+			req: ModifySchemaPlanRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaPlanModifierOrder,
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testSchemaPlanModifierOrder,
+				},
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaPlanModifierOrder,
+				},
+			},
+			expectedResp: ModifySchemaPlanResponse{
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, "first-second"),
+					}),
+					Schema: testSchemaPlanModifierOrder,
+				},
+			},
+		},
+		"attribute-response-plan-not-computed": {
+			req: ModifySchemaPlanRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Optional: true,
+							},
+						},
+					},
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Optional: true,
+							},
+						},
+					},
+				},
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			expectedResp: ModifySchemaPlanResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_a"),
+						"Invalid Plan Modification",
+						"The plan modifiers of test_a changed the planned value of test_b, which is not a computed attribute. "+
+							"Only the planned values of computed attributes can be changed. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_a": tftypes.String,
+							"test_b": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_a": tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_b": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test_a": testschema.AttributeWithStringPlanModifiers{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									testplanmodifier.String{
+										PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+											resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_b"), types.StringValue("test-a-value"))...)
+										},
+									},
+								},
+							},
+							"test_b": testschema.AttributeWithStringPlanModifiers{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
 		"requires-replacement": {
 			req: ModifySchemaPlanRequest{
 				Config: tfsdk.Config{
@@ -5,6 +5,7 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
@@ -20,6 +21,12 @@ type ValidateSchemaRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// SchemaName is the human readable name of the schema, such as
+	// "examplecloud_thing resource", which is included in the schema
+	// deprecation warning. If empty, the warning only contains the schema
+	// deprecation message.
+	SchemaName string
 }
 
 // ValidateSchemaResponse represents a response to a
@@ -69,9 +76,15 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 	}
 
 	if s.GetDeprecationMessage() != "" {
+		detail := s.GetDeprecationMessage()
+
+		if req.SchemaName != "" {
+			detail = fmt.Sprintf("The %s is deprecated.\n\n%s", req.SchemaName, detail)
+		}
+
 		resp.Diagnostics.AddWarning(
 			"Deprecated",
-			s.GetDeprecationMessage(),
+			detail,
 		)
 	}
 }
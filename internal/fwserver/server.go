@@ -33,9 +33,15 @@ type Server struct {
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// ValidateDescriptions enables warning logs during the GetProviderSchema
+	// RPC for attributes and blocks without descriptions, such as for
+	// documentation completeness checks.
+	ValidateDescriptions bool
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
-	// fetched from the DataSourceType.GetSchema() method.
+	// fetched from the DataSourceType.GetSchema() method. Each schema has its
+	// Terraform type precomputed for decoding protocol data.
 	dataSourceSchemas map[string]fwschema.Schema
 
 	// dataSourceSchemasMutex is a mutex to protect concurrent dataSourceSchemas
@@ -117,7 +123,8 @@ type Server struct {
 
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
-	// fetched from the ResourceType.GetSchema() method.
+	// fetched from the ResourceType.GetSchema() method. Each schema has its
+	// Terraform type precomputed for decoding protocol data.
 	resourceSchemas map[string]fwschema.Schema
 
 	// resourceSchemasMutex is a mutex to protect concurrent resourceSchemas
@@ -262,23 +269,32 @@ func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschem
 		return schemaResp.Schema, diags
 	}
 
+	return s.cacheDataSourceSchema(ctx, typeName, schemaResp.Schema), diags
+}
+
+// cacheDataSourceSchema caches the given DataSource Schema for later DataSource
+// operations, with its Terraform type precomputed, and returns the cached
+// Schema.
+func (s *Server) cacheDataSourceSchema(ctx context.Context, typeName string, schema fwschema.Schema) fwschema.Schema {
+	// Precompute the Terraform type once, rather than on every request.
+	cachedSchema := fwschema.NewSchemaWithTerraformType(ctx, schema)
+
 	s.dataSourceSchemasMutex.Lock()
+	defer s.dataSourceSchemasMutex.Unlock()
 
 	if s.dataSourceSchemas == nil {
 		s.dataSourceSchemas = make(map[string]fwschema.Schema)
 	}
 
-	s.dataSourceSchemas[typeName] = schemaResp.Schema
-
-	s.dataSourceSchemasMutex.Unlock()
+	s.dataSourceSchemas[typeName] = cachedSchema
 
-	return schemaResp.Schema, diags
+	return cachedSchema
 }
 
 // DataSourceSchemas returns a map of DataSource Schemas for the
-// GetProviderSchema RPC without caching since not all schemas are guaranteed to
-// be necessary for later provider operations. The schema implementations are
-// also validated.
+// GetProviderSchema RPC. The schema implementations are also validated. Valid
+// schemas are cached for later DataSource operations, so those operations use
+// the same schemas as the GetProviderSchema RPC.
 func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Schema, diag.Diagnostics) {
 	dataSourceSchemas := make(map[string]fwschema.Schema)
 
@@ -309,6 +325,8 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 		}
 
 		dataSourceSchemas[typeName] = schemaResp.Schema
+
+		s.cacheDataSourceSchema(ctx, typeName, schemaResp.Schema)
 	}
 
 	return dataSourceSchemas, diags
@@ -519,23 +537,32 @@ func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.
 		return schemaResp.Schema, diags
 	}
 
+	return s.cacheResourceSchema(ctx, typeName, schemaResp.Schema), diags
+}
+
+// cacheResourceSchema caches the given Resource Schema for later Resource
+// operations, with its Terraform type precomputed, and returns the cached
+// Schema.
+func (s *Server) cacheResourceSchema(ctx context.Context, typeName string, schema fwschema.Schema) fwschema.Schema {
+	// Precompute the Terraform type once, rather than on every request.
+	cachedSchema := fwschema.NewSchemaWithTerraformType(ctx, schema)
+
 	s.resourceSchemasMutex.Lock()
+	defer s.resourceSchemasMutex.Unlock()
 
 	if s.resourceSchemas == nil {
 		s.resourceSchemas = make(map[string]fwschema.Schema)
 	}
 
-	s.resourceSchemas[typeName] = schemaResp.Schema
+	s.resourceSchemas[typeName] = cachedSchema
 
-	s.resourceSchemasMutex.Unlock()
-
-	return schemaResp.Schema, diags
+	return cachedSchema
 }
 
 // ResourceSchemas returns a map of Resource Schemas for the
-// GetProviderSchema RPC without caching since not all schemas are guaranteed to
-// be necessary for later provider operations. The schema implementations are
-// also validated.
+// GetProviderSchema RPC. The schema implementations are also validated. Valid
+// schemas are cached for later Resource operations, so those operations use
+// the same schemas as the GetProviderSchema RPC.
 func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schema, diag.Diagnostics) {
 	resourceSchemas := make(map[string]fwschema.Schema)
 
@@ -566,6 +593,8 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 		}
 
 		resourceSchemas[typeName] = schemaResp.Schema
+
+		s.cacheResourceSchema(ctx, typeName, schemaResp.Schema)
 	}
 
 	return resourceSchemas, diags
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -800,6 +801,28 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -857,6 +880,28 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -914,6 +959,28 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -972,6 +1039,28 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
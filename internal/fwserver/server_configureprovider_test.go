@@ -5,15 +5,20 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -45,6 +50,13 @@ func TestServerConfigureProvider(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testConfigUnknown := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		Schema: testSchema,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *provider.ConfigureRequest
@@ -82,6 +94,45 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &provider.ConfigureResponse{},
 		},
+		"request-config-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						unknown, diags := req.Config.PathIsUnknown(ctx, path.Root("test"))
+
+						resp.Diagnostics.Append(diags...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if !unknown {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected unknown test value")
+
+							return
+						}
+
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if !got.IsUnknown() {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected unknown, got "+got.String())
+						}
+
+						// Intentionally skip creating clients for
+						// resp.DataSourceData and resp.ResourceData.
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfigUnknown,
+			},
+			expectedResponse: &provider.ConfigureResponse{},
+		},
 		"request-terraformversion": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -175,3 +226,115 @@ func TestServerConfigureProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestServerConfigureProvider_providerData(t *testing.T) {
+	t.Parallel()
+
+	type testClient struct {
+		endpoint string
+	}
+
+	client := &testClient{endpoint: "https://example.com"}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testResourceSchema := resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			"test": resourceschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testDataSourceSchema := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"test": datasourceschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{
+			ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+				resp.DataSourceData = client
+				resp.ResourceData = client
+			},
+		},
+	}
+
+	server.ConfigureProvider(context.Background(), &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+
+	var gotResourceClient, gotDataSourceClient *testClient
+
+	readResourceResp := &fwserver.ReadResourceResponse{}
+
+	server.ReadResource(context.Background(), &fwserver.ReadResourceRequest{
+		CurrentState: &tfsdk.State{
+			Raw:    testValue,
+			Schema: testResourceSchema,
+		},
+		Resource: &testprovider.ResourceWithConfigure{
+			ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+				providerClient, ok := req.ProviderData.(*testClient)
+
+				if !ok {
+					resp.Diagnostics.AddError("Unexpected ProviderData Type", fmt.Sprintf("got: %T", req.ProviderData))
+
+					return
+				}
+
+				gotResourceClient = providerClient
+			},
+			Resource: &testprovider.Resource{},
+		},
+	}, readResourceResp)
+
+	if diff := cmp.Diff(readResourceResp.Diagnostics, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("unexpected resource diagnostics difference: %s", diff)
+	}
+
+	if gotResourceClient != client {
+		t.Errorf("expected resource ProviderData %v, got: %v", client, gotResourceClient)
+	}
+
+	readDataSourceResp := &fwserver.ReadDataSourceResponse{}
+
+	server.ReadDataSource(context.Background(), &fwserver.ReadDataSourceRequest{
+		Config: &tfsdk.Config{
+			Raw:    testValue,
+			Schema: testDataSourceSchema,
+		},
+		DataSource: &testprovider.DataSourceWithConfigure{
+			ConfigureMethod: func(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+				providerClient, ok := req.ProviderData.(*testClient)
+
+				if !ok {
+					resp.Diagnostics.AddError("Unexpected ProviderData Type", fmt.Sprintf("got: %T", req.ProviderData))
+
+					return
+				}
+
+				gotDataSourceClient = providerClient
+			},
+			DataSource: &testprovider.DataSource{},
+		},
+		DataSourceSchema: testDataSourceSchema,
+	}, readDataSourceResp)
+
+	if diff := cmp.Diff(readDataSourceResp.Diagnostics, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("unexpected data source diagnostics difference: %s", diff)
+	}
+
+	if gotDataSourceClient != client {
+		t.Errorf("expected data source ProviderData %v, got: %v", client, gotDataSourceClient)
+	}
+}
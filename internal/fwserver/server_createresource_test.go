@@ -6,6 +6,7 @@ package fwserver_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -58,6 +60,18 @@ func TestServerCreateResource(t *testing.T) {
 		},
 	}
 
+	testSchemaWithTrimmedSemanticEquals := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				CustomType: testtypes.StringTypeWithTrimmedSemanticEquals{},
+				Required:   true,
+			},
+		},
+	}
+
 	testSchemaWithSemanticEqualsDiagnostics := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -130,6 +144,51 @@ func TestServerCreateResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testSchemaWithBlock := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testSchemaWithBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_block": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_attribute": tftypes.String,
+					},
+				},
+			},
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchemaWithBlockValue := func(blockElements any) tftypes.Value {
+		return tftypes.NewValue(testSchemaWithBlockType, map[string]tftypes.Value{
+			"test_block": tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_attribute": tftypes.String,
+					},
+				},
+			}, blockElements),
+			"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.CreateResourceRequest
@@ -466,6 +525,52 @@ func TestServerCreateResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-newstate-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+						// Intentionally missing data.TestComputed value
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Returned Unknown Value After Apply",
+						"The Terraform Provider returned an unknown value in the resource state after apply. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Computed attributes which are unknown in the plan must be set to a known value, which can be null, "+
+							"in the resource state before the Create or Update method returns.",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-newstate-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -506,6 +611,75 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-semantic-equality-trimmed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "  test-plannedstate-value\n"),
+					}),
+					Schema: testSchemaWithTrimmedSemanticEquals,
+				},
+				ResourceSchema: testSchemaWithTrimmedSemanticEquals,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data struct {
+							TestComputed types.String                                   `tfsdk:"test_computed"`
+							TestRequired testtypes.StringValueWithTrimmedSemanticEquals `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+						// The API returns the trimmed value, which should be
+						// overwritten back to the plan value.
+						data.TestRequired = testtypes.StringValueWithTrimmedSemanticEquals{
+							StringValue: types.StringValue(strings.TrimSpace(data.TestRequired.ValueString())),
+						}
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "  test-plannedstate-value\n"),
+					}),
+					Schema: testSchemaWithTrimmedSemanticEquals,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-empty-list-block-planned-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				// Empty list and set blocks are converted to null when
+				// the request is decoded.
+				PlannedState: &tfsdk.Plan{
+					Raw:    testSchemaWithBlockValue(nil),
+					Schema: testSchemaWithBlock,
+				},
+				ResourceSchema: testSchemaWithBlock,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.State.Raw = testSchemaWithBlockValue([]tftypes.Value{})
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testSchemaWithBlockValue([]tftypes.Value{}),
+					Schema: testSchemaWithBlock,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
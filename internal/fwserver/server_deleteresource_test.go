@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -144,6 +145,35 @@ func TestServerDeleteResource(t *testing.T) {
 				NewState: testEmptyState,
 			},
 		},
+		"request-priorstate-computed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.DeleteResourceRequest{
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-id"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-priorstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					DeleteMethod: func(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+						var id types.String
+
+						resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("test_computed"), &id)...)
+
+						if id.ValueString() != "test-id" {
+							resp.Diagnostics.AddError("unexpected req.State value: %s", id.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.DeleteResourceResponse{
+				NewState: testEmptyState,
+			},
+		},
 		"request-providermeta": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
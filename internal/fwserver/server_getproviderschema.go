@@ -80,4 +80,8 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 	}
 
 	resp.FunctionDefinitions = functions
+
+	if s.ValidateDescriptions {
+		logMissingSchemaDescriptions(ctx, resp)
+	}
 }
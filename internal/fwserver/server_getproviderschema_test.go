@@ -4,10 +4,12 @@
 package fwserver_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
@@ -944,3 +947,83 @@ func TestServerGetProviderSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestServerGetProviderSchema_validateDescriptions(t *testing.T) {
+	t.Parallel()
+
+	server := &fwserver.Server{
+		ValidateDescriptions: true,
+		Provider: &testprovider.Provider{
+			SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+				resp.Schema = providerschema.Schema{
+					Attributes: map[string]providerschema.Attribute{
+						"test": providerschema.StringAttribute{
+							Description: "test description",
+							Optional:    true,
+						},
+					},
+				}
+			},
+			ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+				return []func() resource.Resource{
+					func() resource.Resource {
+						return &testprovider.Resource{
+							SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+								resp.Schema = resourceschema.Schema{
+									Attributes: map[string]resourceschema.Attribute{
+										"test": resourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								}
+							},
+							MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = "test_resource"
+							},
+						}
+					},
+				}
+			},
+		},
+	}
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	response := &fwserver.GetProviderSchemaResponse{}
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, response)
+
+	if len(response.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	var warnEntries []map[string]interface{}
+
+	for _, entry := range entries {
+		if entry["@level"] == "warn" {
+			warnEntries = append(warnEntries, entry)
+		}
+	}
+
+	expectedWarnEntries := []map[string]interface{}{
+		{
+			"@level":            "warn",
+			"@message":          "Missing resource schema description",
+			"@module":           "sdk.framework",
+			"tf_attribute_path": "test",
+			"tf_resource_type":  "test_resource",
+		},
+	}
+
+	if diff := cmp.Diff(warnEntries, expectedWarnEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -258,11 +258,16 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	if resourceWithModifyPlan, ok := req.Resource.(resource.ResourceWithModifyPlan); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithModifyPlan")
 
+		// Ensure deterministic RequiresReplace in the request, which is
+		// copied so the provider cannot modify the response paths.
+		resp.RequiresReplace = NormaliseRequiresReplace(ctx, resp.RequiresReplace)
+
 		modifyPlanReq := resource.ModifyPlanRequest{
-			Config:  *req.Config,
-			Plan:    stateToPlan(*resp.PlannedState),
-			State:   *req.PriorState,
-			Private: resp.PlannedPrivate.Provider,
+			Config:          *req.Config,
+			Plan:            stateToPlan(*resp.PlannedState),
+			RequiresReplace: append(path.Paths{}, resp.RequiresReplace...),
+			State:           *req.PriorState,
+			Private:         resp.PlannedPrivate.Provider,
 		}
 
 		if req.ProviderMeta != nil {
@@ -276,11 +281,27 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 			Private:         modifyPlanReq.Private,
 		}
 
-		logging.FrameworkTrace(ctx, "Calling provider defined Resource ModifyPlan")
-		resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
-		logging.FrameworkTrace(ctx, "Called provider defined Resource ModifyPlan")
+		// Sensitive values must not be leaked into practitioner facing
+		// diagnostics or logs by provider defined plan modification.
+		sensitiveStrings := schemaSensitiveValueStrings(ctx, req.ResourceSchema, req.Config.Raw, resp.PlannedState.Raw, req.PriorState.Raw)
+		modifyPlanCtx := logging.MaskStrings(ctx, sensitiveStrings...)
+		modifyPlanDiagsIndex := len(resp.Diagnostics)
+
+		logging.FrameworkTrace(modifyPlanCtx, "Calling provider defined Resource ModifyPlan")
+		resourceWithModifyPlan.ModifyPlan(modifyPlanCtx, modifyPlanReq, &modifyPlanResp)
+		logging.FrameworkTrace(modifyPlanCtx, "Called provider defined Resource ModifyPlan")
 
 		resp.Diagnostics = modifyPlanResp.Diagnostics
+
+		// The provider may have removed existing diagnostics.
+		if modifyPlanDiagsIndex > len(resp.Diagnostics) {
+			modifyPlanDiagsIndex = 0
+		}
+
+		resp.Diagnostics = append(
+			resp.Diagnostics[:modifyPlanDiagsIndex],
+			redactSensitiveDiagnostics(resp.Diagnostics[modifyPlanDiagsIndex:], sensitiveStrings)...,
+		)
 		resp.PlannedState = planToState(modifyPlanResp.Plan)
 		resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
 		resp.PlannedPrivate.Provider = modifyPlanResp.Private
@@ -405,6 +426,11 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 // NormaliseRequiresReplace sorts and deduplicates the slice of AttributePaths
 // used in the RequiresReplace response field.
 // Sorting is lexical based on the string representation of each AttributePath.
+//
+// The root path, which marks the whole resource for replacement when any of
+// its values change, sorts before all other paths. Attribute paths are kept
+// alongside it so Terraform can still report which attributes force
+// replacement.
 func NormaliseRequiresReplace(ctx context.Context, rs path.Paths) path.Paths {
 	if len(rs) < 2 {
 		return rs
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -336,6 +337,28 @@ func TestNormaliseRequiresReplace(t *testing.T) {
 				path.Root("name1"),
 			},
 		},
+		"root": {
+			input: path.Paths{
+				path.Empty(),
+			},
+			expected: path.Paths{
+				path.Empty(),
+			},
+		},
+		"root-with-attributes": {
+			input: path.Paths{
+				path.Root("name2"),
+				path.Empty(),
+				path.Root("name1"),
+				path.Empty(),
+				path.Root("name2"),
+			},
+			expected: path.Paths{
+				path.Empty(),
+				path.Root("name1"),
+				path.Root("name2"),
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -999,6 +1022,164 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaTypeDefaultBoolFalse := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_optional_computed": tftypes.Bool,
+		},
+	}
+
+	testSchemaDefaultBoolFalse := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_optional_computed": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+
+	testSchemaTypeNestedComputed := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_object": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_computed":                    tftypes.String,
+					"test_computed_usestateforunknown": tftypes.String,
+					"test_optional":                    tftypes.String,
+				},
+			},
+		},
+	}
+
+	testSchemaNestedComputed := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_object": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_computed": schema.StringAttribute{
+						Computed: true,
+					},
+					"test_computed_usestateforunknown": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"test_optional": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testNestedComputedValue := func(computed, computedUseStateForUnknown, optional tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testSchemaTypeNestedComputed, map[string]tftypes.Value{
+			"test_object": tftypes.NewValue(testSchemaTypeNestedComputed.AttributeTypes["test_object"], map[string]tftypes.Value{
+				"test_computed":                    computed,
+				"test_computed_usestateforunknown": computedUseStateForUnknown,
+				"test_optional":                    optional,
+			}),
+		})
+	}
+
+	testSchemaTypeBlockOptionalComputed := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_block": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_optional":                             tftypes.String,
+						"test_optional_computed":                    tftypes.String,
+						"test_optional_computed_usestateforunknown": tftypes.String,
+					},
+				},
+			},
+		},
+	}
+
+	testSchemaBlockOptionalComputed := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"test_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_optional": schema.StringAttribute{
+							Optional: true,
+						},
+						"test_optional_computed": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"test_optional_computed_usestateforunknown": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testBlockOptionalComputedValue := func(elements ...[3]tftypes.Value) tftypes.Value {
+		listType := testSchemaTypeBlockOptionalComputed.AttributeTypes["test_block"].(tftypes.List)
+		listElements := make([]tftypes.Value, 0, len(elements))
+
+		for _, element := range elements {
+			listElements = append(listElements, tftypes.NewValue(listType.ElementType, map[string]tftypes.Value{
+				"test_optional":                             element[0],
+				"test_optional_computed":                    element[1],
+				"test_optional_computed_usestateforunknown": element[2],
+			}))
+		}
+
+		return tftypes.NewValue(testSchemaTypeBlockOptionalComputed, map[string]tftypes.Value{
+			"test_block": tftypes.NewValue(listType, listElements),
+		})
+	}
+
+	testSchemaAttributePlanModifierResponsePlan := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_other_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), types.StringNull())...)
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testSchemaAttributePlanModifierResponsePlanInvalid := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_other_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), types.BoolValue(true))...)
+						},
+					},
+				},
+			},
+		},
+	}
+
 	testSchemaAttributePlanModifierAttributePlanCustomType := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -1086,6 +1267,36 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaTypeNestedAttributePlanModifier := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_object": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_required": tftypes.String,
+				},
+			},
+		},
+	}
+
+	testSchemaNestedAttributePlanModifierDiagnosticsError := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_object": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_required": schema.StringAttribute{
+						Required: true,
+						PlanModifiers: []planmodifier.String{
+							testplanmodifier.String{
+								PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+									resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+								},
+							},
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+
 	testSchemaAttributePlanModifierRequiresReplace := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -2420,42 +2631,502 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"create-attributeplanmodifier-request-privateplan": {
+		"create-attributeplanmodifier-request-privateplan": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierPrivatePlanRequest,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierPrivatePlanRequest,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchemaAttributePlanModifierPrivatePlanRequest,
+				Resource:       &testprovider.Resource{},
+				PriorPrivate:   testPrivate,
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierPrivatePlanRequest,
+				},
+				PlannedPrivate: testPrivate,
+			},
+		},
+		"create-attributeplanmodifier-response-attributeplan": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, nil),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierAttributePlan,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, "test-attributeplanmodifier-value"),
+						"test_other_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-attributedefault-bool-false": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, nil),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, nil),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeDefaultBoolFalse, nil),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				ResourceSchema: testSchemaDefaultBoolFalse,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, false),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-attributedefault-bool-false-unchanged": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, nil),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, false),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, false),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				ResourceSchema: testSchemaDefaultBoolFalse,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, false),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-attributedefault-bool-false-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, true),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, true),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, false),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				ResourceSchema: testSchemaDefaultBoolFalse,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeDefaultBoolFalse, map[string]tftypes.Value{
+						"test_optional_computed": tftypes.NewValue(tftypes.Bool, true),
+					}),
+					Schema: testSchemaDefaultBoolFalse,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-nested-attribute-computed-unchanged": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, nil),
+						tftypes.NewValue(tftypes.String, nil),
+						tftypes.NewValue(tftypes.String, "test-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				ResourceSchema: testSchemaNestedComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-nested-attribute-computed-sibling-changed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, nil),
+						tftypes.NewValue(tftypes.String, nil),
+						tftypes.NewValue(tftypes.String, "test-new-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-new-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-old-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				ResourceSchema: testSchemaNestedComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: testNestedComputedValue(
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						tftypes.NewValue(tftypes.String, "test-state-value"),
+						tftypes.NewValue(tftypes.String, "test-new-config-value"),
+					),
+					Schema: testSchemaNestedComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-block-optional-computed-unconfigured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, nil),
+							tftypes.NewValue(tftypes.String, nil),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "old-config"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				ResourceSchema: testSchemaBlockOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-block-optional-computed-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, "new-config"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, "new-config"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "old-config"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				ResourceSchema: testSchemaBlockOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, "new-config"),
+							tftypes.NewValue(tftypes.String, "new-config"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-block-optional-computed-block-removed": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
 			request: &fwserver.PlanResourceChangeRequest{
 				Config: &tfsdk.Config{
-					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
-						"test_computed": tftypes.NewValue(tftypes.String, nil),
-						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					Raw:    testBlockOptionalComputedValue(),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testBlockOptionalComputedValue(),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				PriorState: &tfsdk.State{
+					Raw: testBlockOptionalComputedValue(
+						[3]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "old-config"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+							tftypes.NewValue(tftypes.String, "prior-api"),
+						},
+					),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				ResourceSchema: testSchemaBlockOptionalComputed,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testBlockOptionalComputedValue(),
+					Schema: testSchemaBlockOptionalComputed,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-attributeplanmodifier-response-plan": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
 					}),
-					Schema: testSchemaAttributePlanModifierPrivatePlanRequest,
+					Schema: testSchemaAttributePlanModifierResponsePlan,
 				},
 				ProposedNewState: &tfsdk.Plan{
-					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
-						"test_computed": tftypes.NewValue(tftypes.String, nil),
-						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
 					}),
-					Schema: testSchemaAttributePlanModifierPrivatePlanRequest,
+					Schema: testSchemaAttributePlanModifierResponsePlan,
 				},
-				PriorState:     testEmptyState,
-				ResourceSchema: testSchemaAttributePlanModifierPrivatePlanRequest,
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, nil),
+					Schema: testSchemaAttributePlanModifierResponsePlan,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierResponsePlan,
 				Resource:       &testprovider.Resource{},
-				PriorPrivate:   testPrivate,
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
 				PlannedState: &tfsdk.State{
-					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
-						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
 					}),
-					Schema: testSchemaAttributePlanModifierPrivatePlanRequest,
+					Schema: testSchemaAttributePlanModifierResponsePlan,
 				},
-				PlannedPrivate: testPrivate,
+				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"create-attributeplanmodifier-response-attributeplan": {
+		"create-attributeplanmodifier-response-plan-invalid": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -2472,7 +3143,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
 						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
 					}),
-					Schema: testSchemaAttributePlanModifierAttributePlan,
+					Schema: testSchemaAttributePlanModifierResponsePlanInvalid,
 				},
 				ProposedNewState: &tfsdk.Plan{
 					Raw: tftypes.NewValue(tftypes.Object{
@@ -2486,7 +3157,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
 						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
 					}),
-					Schema: testSchemaAttributePlanModifierAttributePlan,
+					Schema: testSchemaAttributePlanModifierResponsePlanInvalid,
 				},
 				PriorState: &tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
@@ -2496,12 +3167,23 @@ func TestServerPlanResourceChange(t *testing.T) {
 							"test_required":       tftypes.String,
 						},
 					}, nil),
-					Schema: testSchemaAttributePlanModifierAttributePlan,
+					Schema: testSchemaAttributePlanModifierResponsePlanInvalid,
 				},
-				ResourceSchema: testSchemaAttributePlanModifierAttributePlan,
+				ResourceSchema: testSchemaAttributePlanModifierResponsePlanInvalid,
 				Resource:       &testprovider.Resource{},
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_other_computed"),
+						"Value Conversion Error",
+						"An unexpected error was encountered while verifying an attribute value matched its expected type to prevent unexpected behavior or panics. "+
+							"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Expected framework type from provider logic: basetypes.StringType / underlying type: tftypes.String\n"+
+							"Received framework type from provider logic: basetypes.BoolType / underlying type: tftypes.Bool\n"+
+							"Path: test_other_computed",
+					),
+				},
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2510,11 +3192,11 @@ func TestServerPlanResourceChange(t *testing.T) {
 							"test_required":       tftypes.String,
 						},
 					}, map[string]tftypes.Value{
-						"test_computed":       tftypes.NewValue(tftypes.String, "test-attributeplanmodifier-value"),
+						"test_computed":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 						"test_other_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
 					}),
-					Schema: testSchemaAttributePlanModifierAttributePlan,
+					Schema: testSchemaAttributePlanModifierResponsePlanInvalid,
 				},
 				PlannedPrivate: testEmptyPrivate,
 			},
@@ -2657,6 +3339,52 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-attributeplanmodifier-response-diagnostics-nested-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeNestedAttributePlanModifier, map[string]tftypes.Value{
+						"test_object": tftypes.NewValue(testSchemaTypeNestedAttributePlanModifier.AttributeTypes["test_object"], map[string]tftypes.Value{
+							"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+						}),
+					}),
+					Schema: testSchemaNestedAttributePlanModifierDiagnosticsError,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeNestedAttributePlanModifier, map[string]tftypes.Value{
+						"test_object": tftypes.NewValue(testSchemaTypeNestedAttributePlanModifier.AttributeTypes["test_object"], map[string]tftypes.Value{
+							"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+						}),
+					}),
+					Schema: testSchemaNestedAttributePlanModifierDiagnosticsError,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeNestedAttributePlanModifier, nil),
+					Schema: testSchemaNestedAttributePlanModifierDiagnosticsError,
+				},
+				ResourceSchema: testSchemaNestedAttributePlanModifierDiagnosticsError,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.WithPath(
+						path.Root("test_object").AtName("test_required"),
+						diag.NewErrorDiagnostic("error summary", "error detail"),
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeNestedAttributePlanModifier, map[string]tftypes.Value{
+						"test_object": tftypes.NewValue(testSchemaTypeNestedAttributePlanModifier.AttributeTypes["test_object"], map[string]tftypes.Value{
+							"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+						}),
+					}),
+					Schema: testSchemaNestedAttributePlanModifierDiagnosticsError,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-attributeplanmodifier-response-requiresreplace": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -3103,6 +3831,82 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testPrivateProvider,
 			},
 		},
+		"create-resourcewithmodifyplan-attributeplanmodifier-plan": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, nil),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierAttributePlan,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						var computed types.String
+
+						resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_computed"), &computed)...)
+
+						if computed.ValueString() != "test-attributeplanmodifier-value" {
+							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+computed.String())
+						}
+
+						resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), computed.ValueString()+"-resourcewithmodifyplan")...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, "test-attributeplanmodifier-value"),
+						"test_other_computed": tftypes.NewValue(tftypes.String, "test-attributeplanmodifier-value-resourcewithmodifyplan"),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"delete-resourcewithmodifyplan-request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -5681,6 +6485,60 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testPrivate,
 			},
 		},
+		"update-resourcewithmodifyplan-request-requiresreplace": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierRequiresReplace,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierRequiresReplace,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierRequiresReplace,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierRequiresReplace,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						expected := path.Paths{path.Root("test_required")}
+
+						if diff := cmp.Diff(req.RequiresReplace, expected); diff != "" {
+							resp.Diagnostics.AddError("Unexpected req.RequiresReplace Value", diff)
+						}
+
+						// Modifying the request paths must not affect the response.
+						req.RequiresReplace[0] = path.Root("test_computed")
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierRequiresReplace,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_required"),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"update-resourcewithmodifyplan-response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -36,6 +36,20 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	if dataSourceWithKnownConfigPaths, ok := req.DataSource.(datasource.DataSourceWithKnownConfigPaths); ok && req.Config != nil {
+		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithKnownConfigPaths")
+
+		logging.FrameworkTrace(ctx, "Calling provider defined DataSource KnownConfigPaths")
+		knownConfigPaths := dataSourceWithKnownConfigPaths.KnownConfigPaths(ctx)
+		logging.FrameworkTrace(ctx, "Called provider defined DataSource KnownConfigPaths")
+
+		resp.Diagnostics.Append(dataSourceKnownConfigDiags(ctx, knownConfigPaths, *req.Config)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -75,17 +89,30 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		readReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	// Sensitive values must not be leaked into practitioner facing
+	// diagnostics or logs by provider defined data source logic.
+	readCtx := logging.MaskStrings(ctx, schemaSensitiveValueStrings(ctx, req.DataSourceSchema, readReq.Config.Raw)...)
+
 	logging.FrameworkTrace(ctx, "Calling provider defined DataSource Read")
-	req.DataSource.Read(ctx, readReq, &readResp)
+	req.DataSource.Read(readCtx, readReq, &readResp)
 	logging.FrameworkTrace(ctx, "Called provider defined DataSource Read")
 
-	resp.Diagnostics = readResp.Diagnostics
+	resp.Diagnostics = redactSensitiveDiagnostics(
+		readResp.Diagnostics,
+		schemaSensitiveValueStrings(ctx, req.DataSourceSchema, readReq.Config.Raw, readResp.State.Raw),
+	)
 	resp.State = &readResp.State
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(dataSourceUnknownValueDiags(ctx, req.DataSourceSchema, resp.State.Raw)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionConfiguration,
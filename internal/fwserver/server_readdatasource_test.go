@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -83,11 +84,65 @@ func TestServerReadDataSource(t *testing.T) {
 		},
 	}
 
+	testSchemaSensitive := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	testListNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_computed": tftypes.String,
+		},
+	}
+
+	testListNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list_nested": tftypes.List{
+				ElementType: testListNestedObjectType,
+			},
+		},
+	}
+
+	testListNestedSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nested_computed": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+
+	type testListNestedModel struct {
+		NestedComputed types.String `tfsdk:"nested_computed"`
+	}
+
 	testConfig := &tfsdk.Config{
 		Raw:    testConfigValue,
 		Schema: testSchema,
 	}
 
+	testConfigUnknown := &tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, nil),
+			"test_required": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		Schema: testSchema,
+	}
+
 	testStateUnchanged := &tfsdk.State{
 		Raw:    testConfigValue,
 		Schema: testSchema,
@@ -135,6 +190,90 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"request-config-knownconfigpaths-known": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSourceWithKnownConfigPaths{
+					DataSource: &testprovider.DataSource{
+						ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {},
+					},
+					KnownConfigPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test_required"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testStateUnchanged,
+			},
+		},
+		"request-config-knownconfigpaths-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfigUnknown,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSourceWithKnownConfigPaths{
+					DataSource: &testprovider.DataSource{
+						ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+							resp.Diagnostics.AddError("unexpected Read call", "")
+						},
+					},
+					KnownConfigPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test_required"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Unknown Data Source Configuration Value",
+						"The data source cannot be read while this configuration value is unknown. "+
+							"Terraform typically waits to read data sources until their configuration is known. "+
+							"If this value depends on changes to other resources, apply those changes first and then try again.",
+					),
+				},
+			},
+		},
+		"request-config-knownconfigpaths-unknown-undeclared": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfigUnknown,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSourceWithKnownConfigPaths{
+					DataSource: &testprovider.DataSource{
+						ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_required"), "test-state-value")...)
+						},
+					},
+					KnownConfigPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test_computed"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"request-providermeta": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -231,6 +370,36 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"response-diagnostics-sensitive": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigValue,
+					Schema: testSchemaSensitive,
+				},
+				DataSourceSchema: testSchemaSensitive,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-state-value")...)
+						resp.Diagnostics.AddError("error summary", "Config value test-config-value returned test-state-value.")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"Config value (sensitive value) returned (sensitive value).",
+					),
+				},
+				State: &tfsdk.State{
+					Raw:    testStateValue,
+					Schema: testSchemaSensitive,
+				},
+			},
+		},
 		"response-diagnostics-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -307,6 +476,142 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testState,
 			},
 		},
+		"response-state-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						var data struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						data.TestComputed = types.StringUnknown()
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Invalid Data Source State",
+						"The Terraform Provider returned an unknown value in the data source state after Read. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Data sources have no plan, so all state values must be known after Read. "+
+							"Set the attribute to a known value, or to a null value if it cannot be determined.",
+					),
+				},
+				State: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"response-state-list-nested": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testListNestedType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testListNestedObjectType}, nil),
+					}),
+					Schema: testListNestedSchema,
+				},
+				DataSourceSchema: testListNestedSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						data := []testListNestedModel{
+							{NestedComputed: types.StringValue("test-value-1")},
+							{NestedComputed: types.StringValue("test-value-2")},
+						}
+
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list_nested"), data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: &tfsdk.State{
+					Raw: tftypes.NewValue(testListNestedType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(
+							tftypes.List{ElementType: testListNestedObjectType},
+							[]tftypes.Value{
+								tftypes.NewValue(testListNestedObjectType, map[string]tftypes.Value{
+									"nested_computed": tftypes.NewValue(tftypes.String, "test-value-1"),
+								}),
+								tftypes.NewValue(testListNestedObjectType, map[string]tftypes.Value{
+									"nested_computed": tftypes.NewValue(tftypes.String, "test-value-2"),
+								}),
+							},
+						),
+					}),
+					Schema: testListNestedSchema,
+				},
+			},
+		},
+		"response-state-list-nested-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testListNestedType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testListNestedObjectType}, nil),
+					}),
+					Schema: testListNestedSchema,
+				},
+				DataSourceSchema: testListNestedSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						data := []testListNestedModel{
+							{NestedComputed: types.StringValue("test-value-1")},
+							{NestedComputed: types.StringUnknown()},
+						}
+
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list_nested"), data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_list_nested").AtListIndex(1).AtName("nested_computed"),
+						"Invalid Data Source State",
+						"The Terraform Provider returned an unknown value in the data source state after Read. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Data sources have no plan, so all state values must be known after Read. "+
+							"Set the attribute to a known value, or to a null value if it cannot be determined.",
+					),
+				},
+				State: &tfsdk.State{
+					Raw: tftypes.NewValue(testListNestedType, map[string]tftypes.Value{
+						"test_list_nested": tftypes.NewValue(
+							tftypes.List{ElementType: testListNestedObjectType},
+							[]tftypes.Value{
+								tftypes.NewValue(testListNestedObjectType, map[string]tftypes.Value{
+									"nested_computed": tftypes.NewValue(tftypes.String, "test-value-1"),
+								}),
+								tftypes.NewValue(testListNestedObjectType, map[string]tftypes.Value{
+									"nested_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+								}),
+							},
+						),
+					}),
+					Schema: testListNestedSchema,
+				},
+			},
+		},
 		"response-state-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
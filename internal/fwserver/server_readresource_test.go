@@ -54,6 +54,18 @@ func TestServerReadResource(t *testing.T) {
 		},
 	}
 
+	testSchemaWithTrimmedSemanticEquals := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				CustomType: testtypes.StringTypeWithTrimmedSemanticEquals{},
+				Required:   true,
+			},
+		},
+	}
+
 	testSchemaWithSemanticEquals := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -471,6 +483,90 @@ func TestServerReadResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-state-semantic-equality-trimmed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "  test-currentstate-value\n"),
+					}),
+					Schema: testSchemaWithTrimmedSemanticEquals,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						var data struct {
+							TestComputed types.String                                   `tfsdk:"test_computed"`
+							TestRequired testtypes.StringValueWithTrimmedSemanticEquals `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+						// The API returns the trimmed value, which should be
+						// overwritten back to the prior state value.
+						data.TestRequired = testtypes.StringValueWithTrimmedSemanticEquals{
+							StringValue: types.StringValue("test-currentstate-value"),
+						}
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "  test-currentstate-value\n"),
+					}),
+					Schema: testSchemaWithTrimmedSemanticEquals,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-semantic-equality-trimmed-changed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "  test-currentstate-value\n"),
+					}),
+					Schema: testSchemaWithTrimmedSemanticEquals,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						var data struct {
+							TestComputed types.String                                   `tfsdk:"test_computed"`
+							TestRequired testtypes.StringValueWithTrimmedSemanticEquals `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+						// The API returns a different value, which should be
+						// saved to detect drift.
+						data.TestRequired = testtypes.StringValueWithTrimmedSemanticEquals{
+							StringValue: types.StringValue("test-other-value"),
+						}
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-other-value"),
+					}),
+					Schema: testSchemaWithTrimmedSemanticEquals,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -169,11 +169,17 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
-	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
-		return
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
 	}
 
-	logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+	resp.Diagnostics.Append(NewStateUnknownValues(ctx, req.ResourceSchema, resp.NewState.Raw)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	resp.Diagnostics.Append(PlannedValueConsistency(ctx, req.ResourceSchema, req.PlannedState.Raw, resp.NewState.Raw)...)
 }
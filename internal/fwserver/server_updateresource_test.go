@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -143,6 +144,51 @@ func TestServerUpdateResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testSchemaWithBlock := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"test_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testSchemaWithBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_block": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_attribute": tftypes.String,
+					},
+				},
+			},
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchemaWithBlockValue := func(blockElements any) tftypes.Value {
+		return tftypes.NewValue(testSchemaWithBlockType, map[string]tftypes.Value{
+			"test_block": tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_attribute": tftypes.String,
+					},
+				},
+			}, blockElements),
+			"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.UpdateResourceRequest
@@ -188,6 +234,28 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -239,6 +307,28 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -290,6 +380,28 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -342,6 +454,28 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Provider Produced Inconsistent Result",
+						"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"Known planned values must be saved into the resource state unchanged. "+
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+							"such as removing any plan modifier or default which sets the value.\n\n"+
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+					),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -777,6 +911,36 @@ func TestServerUpdateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-empty-list-block-planned-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				// Empty list and set blocks are converted to null when
+				// the request is decoded.
+				PlannedState: &tfsdk.Plan{
+					Raw:    testSchemaWithBlockValue(nil),
+					Schema: testSchemaWithBlock,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testSchemaWithBlockValue(nil),
+					Schema: testSchemaWithBlock,
+				},
+				ResourceSchema: testSchemaWithBlock,
+				Resource: &testprovider.Resource{
+					UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.State.Raw = testSchemaWithBlockValue([]tftypes.Value{})
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testSchemaWithBlockValue([]tftypes.Value{}),
+					Schema: testSchemaWithBlock,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -243,5 +243,11 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
+	resp.Diagnostics.Append(UpgradedStateTypeDiags(ctx, req.ResourceSchema, req.Version, upgradeResourceStateResponse.State.Raw)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.UpgradedState = &upgradeResourceStateResponse.State
 }
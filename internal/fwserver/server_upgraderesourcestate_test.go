@@ -627,6 +627,54 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"UpgradedState-type-mismatch": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"optional_attribute": "test-optional-value",
+				}),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(ctx context.Context) map[int64]resource.StateUpgrader {
+						return map[int64]resource.StateUpgrader{
+							0: {
+								StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+									// Purposefully missing the new required_attribute.
+									resp.State.Raw = tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"id":                 tftypes.String,
+												"optional_attribute": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+											"optional_attribute": tftypes.NewValue(tftypes.String, "test-optional-value"),
+										},
+									)
+								},
+							},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("required_attribute"),
+						"Invalid Upgraded Resource State",
+						"After attempting a resource state upgrade to version 0, the provider returned state data that does not match the current schema. "+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+
+							"Missing attribute \"required_attribute\".",
+					),
+				},
+			},
+		},
 		"Version-current-flatmap": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -17,6 +17,10 @@ import (
 type ValidateDataSourceConfigRequest struct {
 	Config     *tfsdk.Config
 	DataSource datasource.DataSource
+
+	// TypeName is the data source type name, which is included in data
+	// source deprecation warnings.
+	TypeName string
 }
 
 // ValidateDataSourceConfigResponse is the framework server response for the
@@ -54,10 +58,16 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		Config: *req.Config,
 	}
 
+	// Sensitive values must not be leaked into practitioner facing
+	// diagnostics or logs by provider defined data source validation.
+	sensitiveStrings := schemaSensitiveValueStrings(ctx, req.Config.Schema, req.Config.Raw)
+	validateCtx := logging.MaskStrings(ctx, sensitiveStrings...)
+	validateDiagsIndex := len(resp.Diagnostics)
+
 	if dataSource, ok := req.DataSource.(datasource.DataSourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigValidators")
 
-		for _, configValidator := range dataSource.ConfigValidators(ctx) {
+		for _, configValidator := range dataSource.ConfigValidators(validateCtx) {
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			vdscResp := &datasource.ValidateConfigResponse{}
@@ -69,7 +79,7 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateDataSource(ctx, vdscReq, vdscResp)
+			configValidator.ValidateDataSource(validateCtx, vdscReq, vdscResp)
 			logging.FrameworkTrace(
 				ctx,
 				"Called provider defined ConfigValidator",
@@ -90,15 +100,24 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		vdscResp := &datasource.ValidateConfigResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined DataSource ValidateConfig")
-		dataSource.ValidateConfig(ctx, vdscReq, vdscResp)
+		dataSource.ValidateConfig(validateCtx, vdscReq, vdscResp)
 		logging.FrameworkTrace(ctx, "Called provider defined DataSource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
 	}
 
+	resp.Diagnostics = append(
+		resp.Diagnostics[:validateDiagsIndex],
+		redactSensitiveDiagnostics(resp.Diagnostics[validateDiagsIndex:], sensitiveStrings)...,
+	)
+
 	validateSchemaReq := ValidateSchemaRequest{
 		Config: *req.Config,
 	}
+
+	if req.TypeName != "" {
+		validateSchemaReq.SchemaName = req.TypeName + " data source"
+	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
 	validateSchemaResp := ValidateSchemaResponse{}
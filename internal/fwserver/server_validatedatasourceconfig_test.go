@@ -89,6 +89,55 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaAttributeDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use the other attribute instead.",
+			},
+		},
+	}
+
+	testConfigAttributeDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaAttributeDeprecated,
+	}
+
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DeprecationMessage: "Use test_data_source_other instead.",
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaDeprecated,
+	}
+
+	testConfigAttributeDeprecatedNull := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchemaAttributeDeprecated,
+	}
+
+	testSchemaSensitive := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	testConfigSensitive := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaSensitive,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateDataSourceConfigRequest
@@ -114,6 +163,85 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{},
 		},
+		"request-config-attribute-deprecated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigAttributeDeprecated,
+				DataSource: &testprovider.DataSource{
+					SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use the other attribute instead.",
+					),
+				},
+			},
+		},
+		"request-config-attribute-deprecated-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigAttributeDeprecatedNull,
+				DataSource: &testprovider.DataSource{
+					SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{},
+		},
+		"request-config-deprecated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigDeprecated,
+				DataSource: &testprovider.DataSource{
+					SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+				TypeName: "test_data_source",
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deprecated",
+						"The test_data_source data source is deprecated.\n\nUse test_data_source_other instead.",
+					),
+				},
+			},
+		},
+		"request-config-deprecated-no-typename": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigDeprecated,
+				DataSource: &testprovider.DataSource{
+					SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deprecated",
+						"Use test_data_source_other instead.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -289,6 +417,33 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-DataSourceWithValidateConfig-diagnostic-sensitive": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigSensitive,
+				DataSource: &testprovider.DataSourceWithValidateConfig{
+					DataSource: &testprovider.DataSource{
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							resp.Schema = testSchemaSensitive
+						},
+					},
+					ValidateConfigMethod: func(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+						resp.Diagnostics.AddAttributeError(path.Root("test"), "error summary", "Invalid value: test-value")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"Invalid value: (sensitive value)",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
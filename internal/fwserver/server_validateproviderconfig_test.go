@@ -89,6 +89,34 @@ func TestServerValidateProviderConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testTypeConflicting := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password": tftypes.String,
+			"token":    tftypes.String,
+		},
+	}
+
+	testValueConflicting := tftypes.NewValue(testTypeConflicting, map[string]tftypes.Value{
+		"password": tftypes.NewValue(tftypes.String, "test-password"),
+		"token":    tftypes.NewValue(tftypes.String, "test-token"),
+	})
+
+	testSchemaConflicting := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConfigConflicting := tfsdk.Config{
+		Raw:    testValueConflicting,
+		Schema: testSchemaConflicting,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateProviderConfigRequest
@@ -295,6 +323,48 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				PreparedConfig: &testConfig,
 			},
 		},
+		"request-config-ProviderWithValidateConfig-attribute-diagnostic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithValidateConfig{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchemaConflicting
+						},
+					},
+					ValidateConfigMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+						var password, token types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if !password.IsNull() && !token.IsNull() {
+							resp.Diagnostics.AddAttributeError(
+								path.Root("token"),
+								"Conflicting Authentication Configuration",
+								"Only one of password or token can be configured.",
+							)
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConfigConflicting,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("token"),
+						"Conflicting Authentication Configuration",
+						"Only one of password or token can be configured.",
+					),
+				},
+				PreparedConfig: &testConfigConflicting,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -17,6 +17,10 @@ import (
 type ValidateResourceConfigRequest struct {
 	Config   *tfsdk.Config
 	Resource resource.Resource
+
+	// TypeName is the resource type name, which is included in resource
+	// deprecation warnings.
+	TypeName string
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -54,10 +58,16 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		Config: *req.Config,
 	}
 
+	// Sensitive values must not be leaked into practitioner facing
+	// diagnostics or logs by provider defined resource validation.
+	sensitiveStrings := schemaSensitiveValueStrings(ctx, req.Config.Schema, req.Config.Raw)
+	validateCtx := logging.MaskStrings(ctx, sensitiveStrings...)
+	validateDiagsIndex := len(resp.Diagnostics)
+
 	if resourceWithConfigValidators, ok := req.Resource.(resource.ResourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigValidators")
 
-		for _, configValidator := range resourceWithConfigValidators.ConfigValidators(ctx) {
+		for _, configValidator := range resourceWithConfigValidators.ConfigValidators(validateCtx) {
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			vdscResp := &resource.ValidateConfigResponse{}
@@ -69,7 +79,7 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateResource(ctx, vdscReq, vdscResp)
+			configValidator.ValidateResource(validateCtx, vdscReq, vdscResp)
 			logging.FrameworkTrace(
 				ctx,
 				"Called provider defined ResourceConfigValidator",
@@ -90,15 +100,24 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		vdscResp := &resource.ValidateConfigResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource ValidateConfig")
-		resourceWithValidateConfig.ValidateConfig(ctx, vdscReq, vdscResp)
+		resourceWithValidateConfig.ValidateConfig(validateCtx, vdscReq, vdscResp)
 		logging.FrameworkTrace(ctx, "Called provider defined Resource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
 	}
 
+	resp.Diagnostics = append(
+		resp.Diagnostics[:validateDiagsIndex],
+		redactSensitiveDiagnostics(resp.Diagnostics[validateDiagsIndex:], sensitiveStrings)...,
+	)
+
 	validateSchemaReq := ValidateSchemaRequest{
 		Config: *req.Config,
 	}
+
+	if req.TypeName != "" {
+		validateSchemaReq.SchemaName = req.TypeName + " resource"
+	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
 	validateSchemaResp := ValidateSchemaResponse{}
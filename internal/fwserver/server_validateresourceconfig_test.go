@@ -5,12 +5,14 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -89,6 +92,163 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaAttributeValidatorDuplicateError := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+						},
+					},
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfigAttributeValidatorDuplicateError := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaAttributeValidatorDuplicateError,
+	}
+
+	testSchemaTypeWithValidate := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				CustomType: testtypes.StringTypeWithValidateError{},
+				Required:   true,
+			},
+		},
+	}
+
+	testConfigTypeWithValidate := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaTypeWithValidate,
+	}
+
+	testSchemaAttributeDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use the other attribute instead.",
+			},
+		},
+	}
+
+	testConfigAttributeDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaAttributeDeprecated,
+	}
+
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DeprecationMessage: "Use test_resource_other instead.",
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaDeprecated,
+	}
+
+	testConfigAttributeDeprecatedNull := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchemaAttributeDeprecated,
+	}
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_object": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"end":   tftypes.Number,
+					"start": tftypes.Number,
+				},
+			},
+		},
+	}
+
+	testNestedObjectValue := func(start, end int64) tftypes.Value {
+		return tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+			"test_object": tftypes.NewValue(testNestedObjectType.AttributeTypes["test_object"], map[string]tftypes.Value{
+				"end":   tftypes.NewValue(tftypes.Number, end),
+				"start": tftypes.NewValue(tftypes.Number, start),
+			}),
+		})
+	}
+
+	testSchemaNestedObjectValidator := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_object": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"end": schema.Int64Attribute{
+						Required: true,
+					},
+					"start": schema.Int64Attribute{
+						Required: true,
+					},
+				},
+				Required: true,
+				Validators: []validator.Object{
+					testvalidator.Object{
+						ValidateObjectMethod: func(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+							var data struct {
+								End   types.Int64 `tfsdk:"end"`
+								Start types.Int64 `tfsdk:"start"`
+							}
+
+							resp.Diagnostics.Append(req.ConfigValue.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+
+							if resp.Diagnostics.HasError() {
+								return
+							}
+
+							if data.Start.ValueInt64() >= data.End.ValueInt64() {
+								resp.Diagnostics.AddAttributeError(
+									req.Path.AtName("end"),
+									"Invalid Attribute Value",
+									fmt.Sprintf("Expected end to be greater than start (%d), got: %d", data.Start.ValueInt64(), data.End.ValueInt64()),
+								)
+							}
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testSchemaNestedAttributeValidator := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_object": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"end": schema.Int64Attribute{
+						Required: true,
+					},
+					"start": schema.Int64Attribute{
+						Required: true,
+						Validators: []validator.Int64{
+							testvalidator.Int64{
+								ValidateInt64Method: func(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+									resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+								},
+							},
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -114,6 +274,85 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-attribute-deprecated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeDeprecated,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use the other attribute instead.",
+					),
+				},
+			},
+		},
+		"request-config-attribute-deprecated-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeDeprecatedNull,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-deprecated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigDeprecated,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deprecated",
+						"The test_resource resource is deprecated.\n\nUse test_resource_other instead.",
+					),
+				},
+			},
+		},
+		"request-config-deprecated-no-typename": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigDeprecated,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deprecated",
+						"Use test_resource_other instead.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -150,6 +389,113 @@ func TestServerValidateResourceConfig(t *testing.T) {
 				},
 			},
 		},
+		"request-config-AttributeValidator-diagnostic-duplicate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorDuplicateError,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorDuplicateError
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"request-config-AttributeValidator-nested-object": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &tfsdk.Config{
+					Raw:    testNestedObjectValue(1, 2),
+					Schema: testSchemaNestedObjectValidator,
+				},
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaNestedObjectValidator
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-AttributeValidator-nested-object-diagnostic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &tfsdk.Config{
+					Raw:    testNestedObjectValue(2, 1),
+					Schema: testSchemaNestedObjectValidator,
+				},
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaNestedObjectValidator
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_object").AtName("end"),
+						"Invalid Attribute Value",
+						"Expected end to be greater than start (2), got: 1",
+					),
+				},
+			},
+		},
+		"request-config-AttributeValidator-nested-attribute-diagnostic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &tfsdk.Config{
+					Raw:    testNestedObjectValue(1, 2),
+					Schema: testSchemaNestedAttributeValidator,
+				},
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaNestedAttributeValidator
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_object").AtName("start"),
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"request-config-TypeWithValidate-diagnostic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigTypeWithValidate,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaTypeWithValidate
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					testtypes.TestErrorDiagnostic(path.Root("test")),
+				},
+			},
+		},
 		"request-config-ResourceWithConfigValidators": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
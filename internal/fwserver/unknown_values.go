@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// unknownValueDiags returns an error diagnostic with the given summary and
+// detail for every unknown value in the given value, such as a resource or
+// data source state which Terraform requires to be wholly known. Only the
+// outermost unknown value of a path is reported and diagnostics are ordered
+// by path so they are deterministic. If the path of an unknown value cannot
+// be converted, the diagnostic is associated with the root path.
+func unknownValueDiags(ctx context.Context, schema fwschema.Schema, value tftypes.Value, summary string, detail string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var tfPaths []*tftypes.AttributePath
+
+	// The walk callback never returns an error.
+	_ = tftypes.Walk(value, func(tfPath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if value.IsKnown() {
+			return true, nil
+		}
+
+		tfPaths = append(tfPaths, tfPath)
+
+		return false, nil
+	})
+
+	// Walk does not guarantee ordering of object attributes and map elements.
+	sort.Slice(tfPaths, func(i, j int) bool {
+		return tfPaths[i].String() < tfPaths[j].String()
+	})
+
+	for _, tfPath := range tfPaths {
+		attributePath, attributePathDiags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+		if attributePathDiags.HasError() {
+			logging.FrameworkDebug(ctx, "Unable to convert unknown value path", map[string]interface{}{
+				logging.KeyError: attributePathDiags.Errors(),
+			})
+
+			attributePath = path.Empty()
+		}
+
+		diags.AddAttributeError(attributePath, summary, detail)
+	}
+
+	return diags
+}
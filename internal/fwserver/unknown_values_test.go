@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnknownValueDiags(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_a": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"test_b": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"known": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_a": tftypes.String,
+					"test_b": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test_a": tftypes.NewValue(tftypes.String, "test"),
+				"test_b": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"unknown-ordered": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_a": tftypes.String,
+					"test_b": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test_a": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"test_b": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_a"), "test summary", "test detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test_b"), "test summary", "test detail"),
+			},
+		},
+		"unknown-path-conversion-error": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_other": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test_other": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Empty(), "test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := unknownValueDiags(context.Background(), testSchema, testCase.value, "test summary", "test detail")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
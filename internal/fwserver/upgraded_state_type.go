@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// UpgradedStateTypeDiags returns an error diagnostic for every difference
+// between the type of the upgraded state value and the schema type, such as a
+// missing attribute, an attribute not in the schema, or a different attribute
+// type. Terraform cannot decode these values, however the protocol encoding
+// error does not include which attribute was the cause.
+//
+// Differences within collection elements are reported on the collection path.
+func UpgradedStateTypeDiags(ctx context.Context, schema fwschema.Schema, version int64, value tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaType := schema.Type().TerraformType(ctx)
+
+	if value.Type() == nil || value.Type().Equal(schemaType) {
+		return diags
+	}
+
+	upgradedStateTypeWalk(tftypes.NewAttributePath(), schemaType, value.Type(), func(tfPath *tftypes.AttributePath, message string) {
+		attributePath, attributePathDiags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+		if attributePathDiags.HasError() {
+			logging.FrameworkDebug(ctx, "Unable to convert upgraded state type path", map[string]interface{}{
+				logging.KeyError: attributePathDiags.Errors(),
+			})
+
+			attributePath = path.Empty()
+		}
+
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Upgraded Resource State",
+			fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider returned state data that does not match the current schema. ", version)+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+
+				message,
+		)
+	})
+
+	return diags
+}
+
+// upgradedStateTypeWalk calls the function with a message for every
+// difference between the expected and actual types, recursing into objects,
+// collections, and tuples. Object attributes are visited in sorted order so
+// differences are reported deterministically.
+func upgradedStateTypeWalk(tfPath *tftypes.AttributePath, expected tftypes.Type, got tftypes.Type, fn func(*tftypes.AttributePath, string)) {
+	if expected.Equal(got) || expected.Is(tftypes.DynamicPseudoType) {
+		return
+	}
+
+	switch expected := expected.(type) {
+	case tftypes.Object:
+		got, ok := got.(tftypes.Object)
+
+		if !ok {
+			break
+		}
+
+		names := make([]string, 0, len(expected.AttributeTypes)+len(got.AttributeTypes))
+
+		for name := range expected.AttributeTypes {
+			names = append(names, name)
+		}
+
+		for name := range got.AttributeTypes {
+			if _, ok := expected.AttributeTypes[name]; !ok {
+				names = append(names, name)
+			}
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			expectedAttributeType, expectedOk := expected.AttributeTypes[name]
+			gotAttributeType, gotOk := got.AttributeTypes[name]
+
+			switch {
+			case !gotOk:
+				fn(tfPath.WithAttributeName(name), fmt.Sprintf("Missing attribute %q.", name))
+			case !expectedOk:
+				fn(tfPath, fmt.Sprintf("Unexpected attribute %q, which is not in the current schema.", name))
+			default:
+				upgradedStateTypeWalk(tfPath.WithAttributeName(name), expectedAttributeType, gotAttributeType, fn)
+			}
+		}
+
+		return
+	case tftypes.List:
+		if got, ok := got.(tftypes.List); ok {
+			upgradedStateTypeWalk(tfPath, expected.ElementType, got.ElementType, upgradedStateTypeElementFn(tfPath, fn))
+
+			return
+		}
+	case tftypes.Map:
+		if got, ok := got.(tftypes.Map); ok {
+			upgradedStateTypeWalk(tfPath, expected.ElementType, got.ElementType, upgradedStateTypeElementFn(tfPath, fn))
+
+			return
+		}
+	case tftypes.Set:
+		if got, ok := got.(tftypes.Set); ok {
+			upgradedStateTypeWalk(tfPath, expected.ElementType, got.ElementType, upgradedStateTypeElementFn(tfPath, fn))
+
+			return
+		}
+	}
+
+	fn(tfPath, fmt.Sprintf("Expected type %s, got: %s", expected, got))
+}
+
+// upgradedStateTypeElementFn returns a function which reports differences
+// within collection element types on the collection path, since element
+// types have no path of their own.
+func upgradedStateTypeElementFn(collectionPath *tftypes.AttributePath, fn func(*tftypes.AttributePath, string)) func(*tftypes.AttributePath, string) {
+	return func(_ *tftypes.AttributePath, message string) {
+		fn(collectionPath, "Collection element type: "+message)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestUpgradedStateTypeDiags(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"test_number": schema.NumberAttribute{
+				Optional: true,
+			},
+			"test_string": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested": tftypes.String,
+		},
+	}
+
+	testDetail := func(message string) string {
+		return "After attempting a resource state upgrade to version 1, the provider returned state data that does not match the current schema. " +
+			"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n" +
+			message
+	}
+
+	testCases := map[string]struct {
+		value         tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"match": {
+			value: tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil),
+		},
+		"attribute-missing": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_list_nested": tftypes.List{ElementType: testNestedObjectType},
+					"test_number":      tftypes.Number,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_string"),
+					"Invalid Upgraded Resource State",
+					testDetail(`Missing attribute "test_string".`),
+				),
+			},
+		},
+		"attribute-type": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_list_nested": tftypes.List{ElementType: testNestedObjectType},
+					"test_number":      tftypes.String,
+					"test_string":      tftypes.String,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_number"),
+					"Invalid Upgraded Resource State",
+					testDetail("Expected type tftypes.Number, got: tftypes.String"),
+				),
+			},
+		},
+		"attribute-unexpected": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_list_nested": tftypes.List{ElementType: testNestedObjectType},
+					"test_number":      tftypes.Number,
+					"test_old":         tftypes.String,
+					"test_string":      tftypes.String,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Upgraded Resource State",
+					testDetail(`Unexpected attribute "test_old", which is not in the current schema.`),
+				),
+			},
+		},
+		"collection-element-attribute-missing": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_list_nested": tftypes.List{ElementType: tftypes.Object{}},
+					"test_number":      tftypes.Number,
+					"test_string":      tftypes.String,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list_nested"),
+					"Invalid Upgraded Resource State",
+					testDetail(`Collection element type: Missing attribute "test_nested".`),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.UpgradedStateTypeDiags(context.Background(), testSchema, 1, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtesting
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Create calls the resource Create method with the given plan model and
+// returns the resulting state decoded into the same model type. The plan
+// model must be a struct with tfsdk field tags matching the resource schema,
+// which is fetched from the resource Schema method. Computed attributes which
+// are set during Create should be unknown in the plan model.
+//
+// The resource configuration and plan are both populated from the plan model.
+// The same framework logic as the ApplyResourceChange RPC is used, including
+// calling the resource Configure method, if implemented, with no provider
+// data, and returning error diagnostics for missing resource state and
+// planned value inconsistencies.
+func Create[T any](ctx context.Context, r resource.Resource, plan T) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var state T
+
+	schemaResp := &resource.SchemaResponse{}
+
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return state, diags
+	}
+
+	plannedState := &tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	diags.Append(plannedState.Set(ctx, plan)...)
+
+	if diags.HasError() {
+		return state, diags
+	}
+
+	createReq := &fwserver.CreateResourceRequest{
+		Config: &tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    plannedState.Raw.Copy(),
+		},
+		PlannedState:   plannedState,
+		ResourceSchema: schemaResp.Schema,
+		Resource:       r,
+	}
+	createResp := &fwserver.CreateResourceResponse{}
+
+	server := &fwserver.Server{}
+
+	server.CreateResource(ctx, createReq, createResp)
+
+	diags.Append(createResp.Diagnostics...)
+
+	if createResp.NewState == nil || createResp.NewState.Raw.IsNull() {
+		return state, diags
+	}
+
+	diags.Append(createResp.NewState.Get(ctx, &state)...)
+
+	return state, diags
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtesting_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtesting"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testCreateModel struct {
+	ID       types.String `tfsdk:"id"`
+	Required types.String `tfsdk:"test_required"`
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		resource            resource.Resource
+		plan                testCreateModel
+		expectedState       testCreateModel
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"state": {
+			resource: &testprovider.Resource{
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = testSchema
+				},
+				CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+					var data testCreateModel
+
+					resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+					data.ID = types.StringValue("test-id")
+
+					resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				},
+			},
+			plan: testCreateModel{
+				ID:       types.StringUnknown(),
+				Required: types.StringValue("test-value"),
+			},
+			expectedState: testCreateModel{
+				ID:       types.StringValue("test-id"),
+				Required: types.StringValue("test-value"),
+			},
+		},
+		"diagnostics": {
+			resource: &testprovider.Resource{
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = testSchema
+				},
+				CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+					var data testCreateModel
+
+					resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+					data.ID = types.StringValue("test-id")
+
+					resp.Diagnostics.AddAttributeWarning(path.Root("test_required"), "warning summary", "warning detail")
+					resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				},
+			},
+			plan: testCreateModel{
+				ID:       types.StringUnknown(),
+				Required: types.StringValue("test-value"),
+			},
+			expectedState: testCreateModel{
+				ID:       types.StringValue("test-id"),
+				Required: types.StringValue("test-value"),
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_required"), "warning summary", "warning detail"),
+			},
+		},
+		"missing-state": {
+			resource: &testprovider.Resource{
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = testSchema
+				},
+				CreateMethod: func(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {},
+			},
+			plan: testCreateModel{
+				ID:       types.StringUnknown(),
+				Required: types.StringValue("test-value"),
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource State After Create",
+					"The Terraform Provider unexpectedly returned no resource state after having no errors in the resource creation. "+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"The resource may have been successfully created, but Terraform is not tracking it. "+
+						"Applying the configuration again with no other action may result in duplicate resource errors.",
+				),
+			},
+		},
+		"planned-value-inconsistency": {
+			resource: &testprovider.Resource{
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = testSchema
+				},
+				CreateMethod: func(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+					data := testCreateModel{
+						ID:       types.StringValue("test-id"),
+						Required: types.StringValue("test-other-value"),
+					}
+
+					resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				},
+			},
+			plan: testCreateModel{
+				ID:       types.StringUnknown(),
+				Required: types.StringValue("test-value"),
+			},
+			expectedState: testCreateModel{
+				ID:       types.StringValue("test-id"),
+				Required: types.StringValue("test-other-value"),
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_required"),
+					"Provider Produced Inconsistent Result",
+					"The Terraform Provider returned a resource state value after apply which does not match the planned value. "+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Known planned values must be saved into the resource state unchanged. "+
+						"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, "+
+						"such as removing any plan modifier or default which sets the value.\n\n"+
+						"Planned Value: \"test-value\"\nNew State Value: \"test-other-value\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotState, gotDiags := fwtesting.Create(context.Background(), testCase.resource, testCase.plan)
+
+			if diff := cmp.Diff(gotState, testCase.expectedState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
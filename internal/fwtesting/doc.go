@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwtesting contains helpers for unit testing provider defined
+// resource methods without a Terraform binary or protocol messages.
+//
+// This package is currently internal while the helper design is evaluated.
+package fwtesting
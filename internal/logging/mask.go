@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// MaskStrings returns a new Context which masks the given strings in log
+// messages and field values of both the framework subsystem logger and the
+// provider root logger. This is used to prevent sensitive values from being
+// written to logs while provider defined logic, such as validators, is
+// operating on them.
+func MaskStrings(ctx context.Context, matchingStrings ...string) context.Context {
+	if len(matchingStrings) == 0 {
+		return ctx
+	}
+
+	ctx = tfsdklog.SubsystemMaskLogStrings(ctx, SubsystemFramework, matchingStrings...)
+	ctx = tflog.MaskLogStrings(ctx, matchingStrings...)
+
+	return ctx
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+func TestMaskStrings(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = tflogtest.RootLogger(ctx, &output)
+	ctx = logging.InitContext(ctx)
+	ctx = logging.MaskStrings(ctx, "secret-value")
+
+	logging.FrameworkDebug(ctx, "framework message secret-value")
+	tflog.Debug(ctx, "provider message secret-value", map[string]interface{}{
+		"field": "secret-value",
+	})
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":   "debug",
+			"@message": "framework message ***",
+			"@module":  "sdk.framework",
+		},
+		{
+			"@level":   "debug",
+			"@message": "provider message ***",
+			"@module":  "provider",
+			"field":    "***",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// dynamicValueIsJSON returns true if the request value is JSON encoded rather
+// than MessagePack encoded. Terraform always sends MessagePack, however other
+// clients, such as debugging tools, may send JSON and expect response values
+// in the same encoding.
+func dynamicValueIsJSON(proto5 *tfprotov5.DynamicValue) bool {
+	return proto5 != nil && len(proto5.MsgPack) == 0 && len(proto5.JSON) > 0
+}
@@ -20,6 +20,12 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	if proto5Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ApplyResourceChange"))
+
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -52,7 +58,16 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto5Req.PlannedState) {
+		return toproto5.ApplyResourceChangeResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
 }
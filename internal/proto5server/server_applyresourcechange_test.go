@@ -4,6 +4,7 @@
 package proto5server
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -11,6 +12,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -78,6 +81,24 @@ func TestServerApplyResourceChange(t *testing.T) {
 		expectedError    error
 		expectedResponse *tfprotov5.ApplyResourceChangeResponse
 	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: nil,
+			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Missing Request Data",
+						Detail: "The ApplyResourceChange RPC was called without any request data. " +
+							"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+					},
+				},
+			},
+		},
 		"create-request-config": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -261,6 +282,60 @@ func TestServerApplyResourceChange(t *testing.T) {
 				}),
 			},
 		},
+		"create-panic": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+											panic("test panic")
+										},
+										DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+											resp.Diagnostics.AddError("Unexpected Method Call", "Expected: Create, Got: Delete")
+										},
+										UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+											resp.Diagnostics.AddError("Unexpected Method Call", "Expected: Create, Got: Update")
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.ApplyResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PriorState: &testEmptyDynamicValue,
+				TypeName:   "test_resource",
+			},
+			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Panic",
+						Detail: "The provider panicked while handling the test_resource request. " +
+							"This is always an issue in the provider and should be reported to the provider developers.\n\n" +
+							"Panic: test panic",
+					},
+				},
+			},
+		},
 		"create-response-diagnostics": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -915,6 +990,30 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_computed"),
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_required"),
+					},
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -975,6 +1074,30 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_computed"),
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_required"),
+					},
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1034,6 +1157,30 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_computed"),
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-config-value\"\nNew State Value: \"test-old-value\"",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_required"),
+					},
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1099,6 +1246,30 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName:     "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_computed"),
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_required"),
+					},
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1168,6 +1339,30 @@ func TestServerApplyResourceChange(t *testing.T) {
 				}),
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-plannedstate-value\"\nNew State Value: <null>",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_computed"),
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Produced Inconsistent Result",
+						Detail: "The Terraform Provider returned a resource state value after apply which does not match the planned value. " +
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+							"Known planned values must be saved into the resource state unchanged. " +
+							"If the value is determined during apply, the attribute should be Computed and the planned value should be unknown, " +
+							"such as removing any plan modifier or default which sets the value.\n\n" +
+							"Planned Value: \"test-new-value\"\nNew State Value: \"test-old-value\"",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test_required"),
+					},
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1433,3 +1628,92 @@ func TestServerApplyResourceChange(t *testing.T) {
 		})
 	}
 }
+
+func TestServerApplyResourceChange_logging(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testValue := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	testEmptyDynamicValue, _ := tfprotov5.NewDynamicValue(testSchemaType, tftypes.NewValue(testSchemaType, nil))
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+									tflog.Info(ctx, "provider log")
+
+									resp.State.Raw = req.Plan.Raw
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	var output bytes.Buffer
+
+	// Emulate the request fields set by the terraform-plugin-go tf5server
+	// RPC handlers, which should be available to provider logging.
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = tflog.SetField(ctx, "tf_resource_type", "test_resource")
+	ctx = tflog.SetField(ctx, "tf_rpc", "ApplyResourceChange")
+
+	_, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		Config:       testValue,
+		PlannedState: testValue,
+		PriorState:   &testEmptyDynamicValue,
+		TypeName:     "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":           "info",
+			"@message":         "provider log",
+			"@module":          "provider",
+			"tf_resource_type": "test_resource",
+			"tf_rpc":           "ApplyResourceChange",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
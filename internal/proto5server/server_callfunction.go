@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -22,6 +23,12 @@ func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunct
 
 	fwResp := &fwserver.CallFunctionResponse{}
 
+	if protoReq == nil {
+		fwResp.Error = function.FuncErrorFromDiags(ctx, diag.Diagnostics{missingRequestDiagnostic(ctx, "CallFunction")})
+
+		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+	}
+
 	serverFunction, err := s.FrameworkServer.Function(ctx, protoReq.Name)
 
 	fwResp.Error = err
@@ -49,7 +56,17 @@ func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunct
 		return toproto5.CallFunctionResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.CallFunction(ctx, fwReq, fwResp)
+	// Recovered panics are returned as a function error, since function
+	// responses do not support diagnostics.
+	var panicDiags diag.Diagnostics
+
+	func() {
+		defer fwserver.RecoverPanic(ctx, protoReq.Name, &panicDiags)
+
+		s.FrameworkServer.CallFunction(ctx, fwReq, fwResp)
+	}()
+
+	fwResp.Error = function.ConcatFuncErrors(fwResp.Error, function.FuncErrorFromDiags(ctx, panicDiags))
 
 	return toproto5.CallFunctionResponse(ctx, fwResp), nil
 }
@@ -122,6 +122,30 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &tfprotov5.ConfigureProviderResponse{},
 		},
+		"response-panic": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							panic("test panic")
+						},
+					},
+				},
+			},
+			request: &tfprotov5.ConfigureProviderRequest{},
+			expectedResponse: &tfprotov5.ConfigureProviderResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Panic",
+						Detail: "The provider panicked while handling the ConfigureProvider request. " +
+							"This is always an issue in the provider and should be reported to the provider developers.\n\n" +
+							"Panic: test panic",
+					},
+				},
+			},
+		},
 		"response-diagnostics": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
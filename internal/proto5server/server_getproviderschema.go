@@ -18,10 +18,27 @@ func (s *Server) GetProviderSchema(ctx context.Context, proto5Req *tfprotov5.Get
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	s.getProviderSchemaResponseMu.Lock()
+	defer s.getProviderSchemaResponseMu.Unlock()
+
+	if s.getProviderSchemaResponse != nil {
+		logging.FrameworkTrace(ctx, "Returning cached GetProviderSchema response")
+
+		return s.getProviderSchemaResponse, nil
+	}
+
 	fwReq := fromproto5.GetProviderSchemaRequest(ctx, proto5Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
-	return toproto5.GetProviderSchemaResponse(ctx, fwResp), nil
+	proto5Resp := toproto5.GetProviderSchemaResponse(ctx, fwResp)
+
+	// Only cache successful responses, so any errors, such as from invalid
+	// schema definitions, are raised again on later calls.
+	if !fwResp.Diagnostics.HasError() {
+		s.getProviderSchemaResponse = proto5Resp
+	}
+
+	return proto5Resp, nil
 }
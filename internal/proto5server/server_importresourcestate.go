@@ -20,6 +20,12 @@ func (s *Server) ImportResourceState(ctx context.Context, proto5Req *tfprotov5.I
 
 	fwResp := &fwserver.ImportResourceStateResponse{}
 
+	if proto5Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ImportResourceState"))
+
+		return toproto5.ImportResourceStateResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -44,7 +50,11 @@ func (s *Server) ImportResourceState(ctx context.Context, proto5Req *tfprotov5.I
 		return toproto5.ImportResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	}()
 
 	return toproto5.ImportResourceStateResponse(ctx, fwResp), nil
 }
@@ -48,7 +48,11 @@ func (s *Server) MoveResourceState(ctx context.Context, proto5Req *tfprotov5.Mov
 		return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.MoveResourceState(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TargetTypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.MoveResourceState(ctx, fwReq, fwResp)
+	}()
 
 	return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
 }
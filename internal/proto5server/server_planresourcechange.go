@@ -20,6 +20,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	if proto5Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "PlanResourceChange"))
+
+		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -52,7 +58,16 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto5Req.ProposedNewState) {
+		return toproto5.PlanResourceChangeResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
 }
@@ -36,7 +36,11 @@ func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5
 		return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, "PrepareProviderConfig", &fwResp.Diagnostics)
+
+		s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	}()
 
 	return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
 }
@@ -20,6 +20,12 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	if proto5Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ReadDataSource"))
+
+		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -52,7 +58,16 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto5Req.Config) {
+		return toproto5.ReadDataSourceResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
 }
@@ -21,6 +21,12 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	if proto5Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ReadResource"))
+
+		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -53,7 +59,16 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 		return toproto5.ReadResourceResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ReadResource(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ReadResource(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto5Req.CurrentState) {
+		return toproto5.ReadResourceResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto5.ReadResourceResponse(ctx, fwResp), nil
 }
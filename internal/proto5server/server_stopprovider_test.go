@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestServerStopProvider(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testCurrentStateValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+	})
+
+	readStarted := make(chan struct{})
+	readCtxErr := make(chan error, 1)
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"test_required": schema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+									close(readStarted)
+
+									select {
+									case <-ctx.Done():
+										readCtxErr <- ctx.Err()
+									case <-time.After(10 * time.Second):
+										readCtxErr <- nil
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	go func() {
+		_, _ = server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+			CurrentState: testCurrentStateValue,
+			TypeName:     "test_resource",
+		})
+	}()
+
+	<-readStarted
+
+	_, err := server.StopProvider(context.Background(), &tfprotov5.StopProviderRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected StopProvider error: %s", err)
+	}
+
+	if got := <-readCtxErr; got != context.Canceled {
+		t.Errorf("expected Read context to be canceled, got: %v", got)
+	}
+}
@@ -48,7 +48,11 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
+	}()
 
 	return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
 }
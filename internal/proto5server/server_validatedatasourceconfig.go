@@ -20,6 +20,12 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	if proto5Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ValidateDataSourceConfig"))
+
+		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -44,7 +50,11 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	}()
 
 	return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 }
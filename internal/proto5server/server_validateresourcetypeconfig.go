@@ -20,6 +20,12 @@ func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfpr
 
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	if proto5Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ValidateResourceTypeConfig"))
+
+		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -44,7 +50,11 @@ func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfpr
 		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto5Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
+	}()
 
 	return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
 }
@@ -49,6 +49,24 @@ func TestServerValidateResourceTypeConfig(t *testing.T) {
 		expectedError    error
 		expectedResponse *tfprotov5.ValidateResourceTypeConfigResponse
 	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: nil,
+			expectedResponse: &tfprotov5.ValidateResourceTypeConfigResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Missing Request Data",
+						Detail: "The ValidateResourceTypeConfig RPC was called without any request data. " +
+							"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+					},
+				},
+			},
+		},
 		"no-schema": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
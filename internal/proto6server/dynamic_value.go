@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// dynamicValueIsJSON returns true if the request value is JSON encoded rather
+// than MessagePack encoded. Terraform always sends MessagePack, however other
+// clients, such as debugging tools, may send JSON and expect response values
+// in the same encoding.
+func dynamicValueIsJSON(proto6 *tfprotov6.DynamicValue) bool {
+	return proto6 != nil && len(proto6.MsgPack) == 0 && len(proto6.JSON) > 0
+}
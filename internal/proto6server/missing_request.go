@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// missingRequestDiagnostic returns an error diagnostic for an RPC which was
+// called without any request data. The RPC methods return this diagnostic
+// rather than panicking when accessing the request.
+func missingRequestDiagnostic(ctx context.Context, rpc string) diag.Diagnostic {
+	logging.FrameworkError(ctx, "Received missing request data for "+rpc)
+
+	return diag.NewErrorDiagnostic(
+		"Missing Request Data",
+		fmt.Sprintf("The %s RPC was called without any request data. ", rpc)+
+			"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+	)
+}
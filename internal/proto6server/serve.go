@@ -19,6 +19,17 @@ type Server struct {
 
 	contextCancels   []context.CancelFunc
 	contextCancelsMu sync.Mutex
+
+	// getProviderSchemaResponse is the cached GetProviderSchema RPC response.
+	// Schemas are static for the lifetime of the server, so the framework
+	// schemas are only fetched, validated, and converted once. The cache is
+	// typically populated when the server starts and responses with error
+	// diagnostics are never cached.
+	getProviderSchemaResponse *tfprotov6.GetProviderSchemaResponse
+
+	// getProviderSchemaResponseMu is a mutex to protect concurrent
+	// getProviderSchemaResponse access from race conditions.
+	getProviderSchemaResponseMu sync.Mutex
 }
 
 func (s *Server) registerContext(in context.Context) context.Context {
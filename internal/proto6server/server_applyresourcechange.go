@@ -20,6 +20,12 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	if proto6Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ApplyResourceChange"))
+
+		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -52,7 +58,16 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto6Req.PlannedState) {
+		return toproto6.ApplyResourceChangeResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
 }
@@ -28,6 +28,20 @@ func TestServerCallFunction(t *testing.T) {
 		expectedError    error
 		expectedResponse *tfprotov6.CallFunctionResponse
 	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: nil,
+			expectedResponse: &tfprotov6.CallFunctionResponse{
+				Error: &tfprotov6.FunctionError{
+					Text: "Missing Request Data: The CallFunction RPC was called without any request data. " +
+						"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+				},
+			},
+		},
 		"request-arguments": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -197,6 +211,44 @@ func TestServerCallFunction(t *testing.T) {
 				Result: testNewSingleValueDynamicValue(t, tftypes.NewValue(tftypes.String, "result")),
 			},
 		},
+		"response-panic": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithFunctions{
+						FunctionsMethod: func(ctx context.Context) []func() function.Function {
+							return []func() function.Function{
+								func() function.Function {
+									return &testprovider.Function{
+										MetadataMethod: func(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+											resp.Name = "testfunction"
+										},
+										DefinitionMethod: func(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+											resp.Definition = function.Definition{
+												Return: function.StringReturn{},
+											}
+										},
+										RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+											panic("test panic")
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.CallFunctionRequest{
+				Arguments: []*tfprotov6.DynamicValue{},
+				Name:      "testfunction",
+			},
+			expectedResponse: &tfprotov6.CallFunctionResponse{
+				Error: &tfprotov6.FunctionError{
+					Text: "Provider Panic: The provider panicked while handling the testfunction request. " +
+						"This is always an issue in the provider and should be reported to the provider developers.\n\n" +
+						"Panic: test panic",
+				},
+			},
+		},
 		"response-result": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
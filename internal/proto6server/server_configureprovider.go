@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -36,7 +37,11 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.Con
 		return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, "ConfigureProvider", &fwResp.Diagnostics)
+
+		s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
+	}()
 
 	return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
 }
@@ -122,6 +122,30 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &tfprotov6.ConfigureProviderResponse{},
 		},
+		"response-panic": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							panic("test panic")
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ConfigureProviderRequest{},
+			expectedResponse: &tfprotov6.ConfigureProviderResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Provider Panic",
+						Detail: "The provider panicked while handling the ConfigureProvider request. " +
+							"This is always an issue in the provider and should be reported to the provider developers.\n\n" +
+							"Panic: test panic",
+					},
+				},
+			},
+		},
 		"response-diagnostics": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
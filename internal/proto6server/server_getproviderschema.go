@@ -18,10 +18,27 @@ func (s *Server) GetProviderSchema(ctx context.Context, proto6Req *tfprotov6.Get
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	s.getProviderSchemaResponseMu.Lock()
+	defer s.getProviderSchemaResponseMu.Unlock()
+
+	if s.getProviderSchemaResponse != nil {
+		logging.FrameworkTrace(ctx, "Returning cached GetProviderSchema response")
+
+		return s.getProviderSchemaResponse, nil
+	}
+
 	fwReq := fromproto6.GetProviderSchemaRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
-	return toproto6.GetProviderSchemaResponse(ctx, fwResp), nil
+	proto6Resp := toproto6.GetProviderSchemaResponse(ctx, fwResp)
+
+	// Only cache successful responses, so any errors, such as from invalid
+	// schema definitions, are raised again on later calls.
+	if !fwResp.Diagnostics.HasError() {
+		s.getProviderSchemaResponse = proto6Resp
+	}
+
+	return proto6Resp, nil
 }
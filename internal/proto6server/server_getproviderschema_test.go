@@ -780,3 +780,135 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 		t.Errorf("unexpected difference: %s", diff)
 	}
 }
+
+func TestServerGetProviderSchema_cached(t *testing.T) {
+	t.Parallel()
+
+	var schemaCalls int
+
+	testServer := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									schemaCalls++
+
+									resp.Schema = resourceschema.Schema{
+										Attributes: map[string]resourceschema.Attribute{
+											"test": resourceschema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	firstResp, err := testServer.GetProviderSchema(context.Background(), new(tfprotov6.GetProviderSchemaRequest))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secondResp, err := testServer.GetProviderSchema(context.Background(), new(tfprotov6.GetProviderSchemaRequest))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Later resource operations should use the schema cached by the
+	// GetProviderSchema RPC.
+	_, diags := testServer.FrameworkServer.ResourceSchema(context.Background(), "test_resource")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if schemaCalls != 1 {
+		t.Errorf("expected resource Schema to be called once, got %d calls", schemaCalls)
+	}
+
+	if diff := cmp.Diff(firstResp, secondResp); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestServerGetProviderSchema_errorNotCached(t *testing.T) {
+	t.Parallel()
+
+	var schemaCalls int
+
+	testServer := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									schemaCalls++
+
+									if schemaCalls == 1 {
+										resp.Diagnostics.AddError("test summary", "test detail")
+
+										return
+									}
+
+									resp.Schema = resourceschema.Schema{
+										Attributes: map[string]resourceschema.Attribute{
+											"test": resourceschema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	firstResp, err := testServer.GetProviderSchema(context.Background(), new(tfprotov6.GetProviderSchemaRequest))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(firstResp.Diagnostics) == 0 {
+		t.Fatalf("expected diagnostics in first response")
+	}
+
+	secondResp, err := testServer.GetProviderSchema(context.Background(), new(tfprotov6.GetProviderSchemaRequest))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(secondResp.Diagnostics) != 0 {
+		t.Errorf("unexpected diagnostics in second response: %v", secondResp.Diagnostics)
+	}
+
+	if _, ok := secondResp.ResourceSchemas["test_resource"]; !ok {
+		t.Errorf("expected test_resource schema in second response")
+	}
+
+	if schemaCalls != 2 {
+		t.Errorf("expected resource Schema to be called twice, got %d calls", schemaCalls)
+	}
+}
@@ -20,6 +20,12 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 
 	fwResp := &fwserver.ImportResourceStateResponse{}
 
+	if proto6Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ImportResourceState"))
+
+		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -44,7 +50,11 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	}()
 
 	return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
 }
@@ -56,6 +56,24 @@ func TestServerImportResourceState(t *testing.T) {
 		expectedError    error
 		expectedResponse *tfprotov6.ImportResourceStateResponse
 	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: nil,
+			expectedResponse: &tfprotov6.ImportResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Missing Request Data",
+						Detail: "The ImportResourceState RPC was called without any request data. " +
+							"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+					},
+				},
+			},
+		},
 		"request-id": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
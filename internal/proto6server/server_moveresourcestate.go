@@ -48,7 +48,11 @@ func (s *Server) MoveResourceState(ctx context.Context, proto6Req *tfprotov6.Mov
 		return toproto6.MoveResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.MoveResourceState(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TargetTypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.MoveResourceState(ctx, fwReq, fwResp)
+	}()
 
 	return toproto6.MoveResourceStateResponse(ctx, fwResp), nil
 }
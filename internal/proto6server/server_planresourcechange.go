@@ -20,6 +20,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	if proto6Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "PlanResourceChange"))
+
+		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -52,7 +58,16 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto6Req.ProposedNewState) {
+		return toproto6.PlanResourceChangeResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
 }
@@ -76,6 +76,24 @@ func TestServerPlanResourceChange(t *testing.T) {
 		expectedError    error
 		expectedResponse *tfprotov6.PlanResourceChangeResponse
 	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: nil,
+			expectedResponse: &tfprotov6.PlanResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Missing Request Data",
+						Detail: "The PlanResourceChange RPC was called without any request data. " +
+							"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+					},
+				},
+			},
+		},
 		"create-request-config": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
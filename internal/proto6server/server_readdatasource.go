@@ -20,6 +20,12 @@ func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDa
 
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	if proto6Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ReadDataSource"))
+
+		return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -52,7 +58,16 @@ func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDa
 		return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto6Req.Config) {
+		return toproto6.ReadDataSourceResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
 }
@@ -71,6 +71,24 @@ func TestServerReadDataSource(t *testing.T) {
 		expectedError    error
 		expectedResponse *tfprotov6.ReadDataSourceResponse
 	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: nil,
+			expectedResponse: &tfprotov6.ReadDataSourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Missing Request Data",
+						Detail: "The ReadDataSource RPC was called without any request data. " +
+							"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+					},
+				},
+			},
+		},
 		"no-schema": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
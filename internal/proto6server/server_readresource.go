@@ -20,6 +20,12 @@ func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadReso
 
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	if proto6Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ReadResource"))
+
+		return toproto6.ReadResourceResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -52,7 +58,16 @@ func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadReso
 		return toproto6.ReadResourceResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ReadResource(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ReadResource(ctx, fwReq, fwResp)
+	}()
+
+	// Respond with the same value encoding as the request.
+	if dynamicValueIsJSON(proto6Req.CurrentState) {
+		return toproto6.ReadResourceResponseJSON(ctx, fwResp), nil
+	}
 
 	return toproto6.ReadResourceResponse(ctx, fwResp), nil
 }
@@ -76,6 +76,24 @@ func TestServerReadResource(t *testing.T) {
 		expectedError    error
 		expectedResponse *tfprotov6.ReadResourceResponse
 	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: nil,
+			expectedResponse: &tfprotov6.ReadResourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Missing Request Data",
+						Detail: "The ReadResource RPC was called without any request data. " +
+							"This is always an issue with Terraform or terraform-plugin-framework and should be reported to the provider developers.",
+					},
+				},
+			},
+		},
 		"no-schema": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -144,6 +162,55 @@ func TestServerReadResource(t *testing.T) {
 				NewState: testCurrentStateValue,
 			},
 		},
+		"request-currentstate-json": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+											var data struct {
+												TestComputed types.String `tfsdk:"test_computed"`
+												TestRequired types.String `tfsdk:"test_required"`
+											}
+
+											resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+											if data.TestRequired.ValueString() != "test-currentstate-value" {
+												resp.Diagnostics.AddError("unexpected req.State value: %s", data.TestRequired.ValueString())
+											}
+
+											data.TestComputed = types.StringValue("test-newstate-value")
+
+											resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ReadResourceRequest{
+				CurrentState: &tfprotov6.DynamicValue{
+					JSON: []byte(`{"test_computed":null,"test_required":"test-currentstate-value"}`),
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov6.ReadResourceResponse{
+				NewState: &tfprotov6.DynamicValue{
+					JSON: []byte(`{"test_computed":"test-newstate-value","test_required":"test-currentstate-value"}`),
+				},
+			},
+		},
 		"request-providermeta": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
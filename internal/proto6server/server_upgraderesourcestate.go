@@ -48,7 +48,11 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
+	}()
 
 	return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
 }
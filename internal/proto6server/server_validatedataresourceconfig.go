@@ -20,6 +20,12 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	if proto6Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ValidateDataResourceConfig"))
+
+		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -44,7 +50,11 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	}()
 
 	return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 }
@@ -36,7 +36,11 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov
 		return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, "ValidateProviderConfig", &fwResp.Diagnostics)
+
+		s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	}()
 
 	return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
 }
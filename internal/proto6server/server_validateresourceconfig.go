@@ -20,6 +20,12 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	if proto6Req == nil {
+		fwResp.Diagnostics.Append(missingRequestDiagnostic(ctx, "ValidateResourceConfig"))
+
+		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -44,7 +50,11 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
+	func() {
+		defer fwserver.RecoverPanic(ctx, proto6Req.TypeName, &fwResp.Diagnostics)
+
+		s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
+	}()
 
 	return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
 }
@@ -49,6 +49,10 @@ type DiagIntoIncompatibleType struct {
 	Val        tftypes.Value
 	TargetType reflect.Type
 	Err        error
+
+	// SuggestedType, if set, is included in the diagnostic detail as
+	// guidance for which Go types match the schema type.
+	SuggestedType string
 }
 
 func (d DiagIntoIncompatibleType) Severity() diag.Severity {
@@ -60,7 +64,13 @@ func (d DiagIntoIncompatibleType) Summary() string {
 }
 
 func (d DiagIntoIncompatibleType) Detail() string {
-	return fmt.Sprintf("An unexpected error was encountered trying to convert %T into %s. This is always an error in the provider. Please report the following to the provider developer:\n\n%s", d.Val, d.TargetType, d.Err.Error())
+	detail := fmt.Sprintf("An unexpected error was encountered trying to convert %T into %s. This is always an error in the provider. Please report the following to the provider developer:\n\n%s", d.Val, d.TargetType, d.Err.Error())
+
+	if d.SuggestedType != "" {
+		detail += "\n\nSuggested Type: " + d.SuggestedType
+	}
+
+	return detail
 }
 
 func (d DiagIntoIncompatibleType) Equal(o diag.Diagnostic) bool {
@@ -77,6 +87,9 @@ func (d DiagIntoIncompatibleType) Equal(o diag.Diagnostic) bool {
 	if d.Err.Error() != od.Err.Error() {
 		return false
 	}
+	if d.SuggestedType != od.SuggestedType {
+		return false
+	}
 	return true
 }
 
@@ -45,38 +45,73 @@ func commaSeparatedString(in []string) string {
 	}
 }
 
-// getStructTags returns a map of Terraform field names to their position in
-// the tags of the struct `in`. `in` must be a struct.
-func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string]int, error) {
-	tags := map[string]int{}
+// getStructTags returns a map of Terraform field names to the index sequence
+// of their field in the struct `in`, suitable for reflect.Value.FieldByIndex.
+// `in` must be a struct. Fields of embedded structs without a `tfsdk` struct
+// tag are promoted, as if they were declared in `in` itself.
+func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string][]int, error) {
 	typ := trueReflectValue(in).Type()
 	if typ.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, in.Type())
 	}
+	tags := map[string][]int{}
+	fieldNames := map[string]string{}
+	err := getStructTagsRecursive(typ, nil, "", path, tags, fieldNames)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// StructTags returns a map of Terraform field names to the index sequence of
+// their field in the struct type `typ`, following the same `tfsdk` struct tag
+// rules as converting values to and from structs.
+func StructTags(ctx context.Context, typ reflect.Type, path path.Path) (map[string][]int, error) {
+	return getStructTags(ctx, reflect.New(typ).Elem(), path)
+}
+
+// getStructTagsRecursive adds the Terraform field names of the struct type
+// `typ` to `tags`, recursing into embedded structs without a `tfsdk` struct
+// tag. `index` and `prefix` are the index sequence and Go field name of the
+// embedded struct being visited, if any. `fieldNames` tracks the Go field
+// name for each Terraform field name, for reporting collisions.
+func getStructTagsRecursive(typ reflect.Type, index []int, prefix string, path path.Path, tags map[string][]int, fieldNames map[string]string) error {
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldName := prefix + field.Name
+		tag := field.Tag.Get(`tfsdk`)
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			// promote the fields of embedded structs, which may have
+			// an unexported type but still contain exported fields
+			err := getStructTagsRecursive(field.Type, fieldIndex, fieldName+".", path, tags, fieldNames)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		if field.PkgPath != "" {
 			// skip unexported fields
 			continue
 		}
-		tag := field.Tag.Get(`tfsdk`)
 		if tag == "-" {
 			// skip explicitly excluded fields
 			continue
 		}
 		if tag == "" {
-			return nil, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name)
+			return fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s, or a tfsdk:"-" struct tag to ignore the field`, path, fieldName)
 		}
 		path := path.AtName(tag)
 		if !isValidFieldName(tag) {
-			return nil, fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path)
+			return fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path)
 		}
-		if other, ok := tags[tag]; ok {
-			return nil, fmt.Errorf("%s: can't use field name for both %s and %s", path, typ.Field(other).Name, field.Name)
+		if other, ok := fieldNames[tag]; ok {
+			return fmt.Errorf("%s: can't use field name for both %s and %s", path, other, fieldName)
 		}
-		tags[tag] = i
+		tags[tag] = fieldIndex
+		fieldNames[tag] = fieldName
 	}
-	return tags, nil
+	return nil
 }
 
 // isValidFieldName returns true if `name` can be used as a field name in a
@@ -4,9 +4,14 @@
 package reflect
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 func TestTrueReflectValue(t *testing.T) {
@@ -93,6 +98,144 @@ func TestCommaSeparatedString(t *testing.T) {
 	}
 }
 
+func TestGetStructTags(t *testing.T) {
+	t.Parallel()
+
+	type EmbeddedStruct struct {
+		EmbeddedAttribute string `tfsdk:"embedded_attribute"`
+		Ignored           string `tfsdk:"-"`
+	}
+
+	type embeddedUnexportedStruct struct {
+		EmbeddedAttribute string `tfsdk:"embedded_attribute"`
+	}
+
+	type EmbeddedCollisionStruct struct {
+		Attribute string `tfsdk:"attribute"`
+	}
+
+	testCases := map[string]struct {
+		in            any
+		expected      map[string][]int
+		expectedError error
+	}{
+		"renamed-fields": {
+			in: struct {
+				ExampleAttribute string `tfsdk:"example_attribute"`
+				Other            string `tfsdk:"renamed"`
+			}{},
+			expected: map[string][]int{
+				"example_attribute": {0},
+				"renamed":           {1},
+			},
+		},
+		"ignored-fields": {
+			in: struct {
+				Ignored  string `tfsdk:"-"`
+				Included string `tfsdk:"included"`
+				//nolint:unused // Intentionally testing unexported field handling
+				unexported string
+			}{},
+			expected: map[string][]int{
+				"included": {1},
+			},
+		},
+		"embedded-struct": {
+			in: struct {
+				Attribute string `tfsdk:"attribute"`
+				EmbeddedStruct
+			}{},
+			expected: map[string][]int{
+				"attribute":          {0},
+				"embedded_attribute": {1, 0},
+			},
+		},
+		"embedded-struct-unexported-type": {
+			in: struct {
+				Attribute string `tfsdk:"attribute"`
+				embeddedUnexportedStruct
+			}{},
+			expected: map[string][]int{
+				"attribute":          {0},
+				"embedded_attribute": {1, 0},
+			},
+		},
+		"embedded-struct-tagged": {
+			in: struct {
+				Attribute      string `tfsdk:"attribute"`
+				EmbeddedStruct `tfsdk:"embedded"`
+			}{},
+			expected: map[string][]int{
+				"attribute": {0},
+				"embedded":  {1},
+			},
+		},
+		"embedded-struct-ignored": {
+			in: struct {
+				Attribute      string `tfsdk:"attribute"`
+				EmbeddedStruct `tfsdk:"-"`
+			}{},
+			expected: map[string][]int{
+				"attribute": {0},
+			},
+		},
+		"embedded-struct-collision": {
+			in: struct {
+				Attribute string `tfsdk:"attribute"`
+				EmbeddedCollisionStruct
+			}{},
+			expectedError: fmt.Errorf("test.attribute: can't use field name for both Attribute and EmbeddedCollisionStruct.Attribute"),
+		},
+		"missing-tag": {
+			in: struct {
+				Included string `tfsdk:"included"`
+				Untagged string
+			}{},
+			expectedError: fmt.Errorf(`test: need a struct tag for "tfsdk" on Untagged, or a tfsdk:"-" struct tag to ignore the field`),
+		},
+		"other-tag-only": {
+			in: struct {
+				Untagged string `json:"untagged"`
+			}{},
+			expectedError: fmt.Errorf(`test: need a struct tag for "tfsdk" on Untagged, or a tfsdk:"-" struct tag to ignore the field`),
+		},
+		"not-a-struct": {
+			in:            "test",
+			expectedError: fmt.Errorf("test: can't get struct tags of string, is not a struct"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getStructTags(context.Background(), reflect.ValueOf(testCase.in), path.Root("test"))
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestIsValidFieldName(t *testing.T) {
 	t.Parallel()
 	tests := map[string]bool{
@@ -26,6 +26,11 @@ import (
 // in the tftypes.Value must have a corresponding property in the struct. Into
 // will be called for each struct field. Slices will have Into called for each
 // element.
+//
+// Null lists, sets, and maps are set as nil slices and maps, while known
+// empty lists, sets, and maps are set as non-nil, zero-length slices and maps.
+// This preserves the distinction between unset and empty collections. FromValue
+// performs the inverse mapping.
 func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interface{}, opts Options, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -114,11 +119,19 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		// all that's left to us now is to set it as an empty value or
 		// throw an error, depending on what's in opts
 		if !opts.UnhandledUnknownAsEmpty {
+			guidance := "Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values."
+
+			// Pointer types are a common choice for optional attributes, so
+			// explain why they are not sufficient here.
+			if target.Kind() == reflect.Ptr {
+				guidance += " Pointer types can represent null values as nil, but cannot represent unknown values."
+			}
+
 			diags.AddAttributeError(
 				path,
 				"Value Conversion Error",
 				"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-					"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+					guidance+"\n\n"+
 					fmt.Sprintf("Path: %s\nTarget Type: %s\nSuggested Type: %s", path.String(), target.Type(), reflect.TypeOf(typ.ValueType(ctx))),
 			)
 			return target, diags
@@ -153,6 +166,11 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 	if target.Type() == reflect.TypeOf(big.NewFloat(0)) || target.Type() == reflect.TypeOf(big.NewInt(0)) {
 		return Number(ctx, typ, val, target, opts, path)
 	}
+	// time.Time is technically a struct, but we want it handled as an
+	// RFC3339 string
+	if target.Type() == timeType {
+		return Time(ctx, typ, val, target, path)
+	}
 	switch target.Kind() {
 	case reflect.Struct:
 		val, valDiags := Struct(ctx, typ, val, target, opts, path)
@@ -39,6 +39,22 @@ func TestInto_Slices(t *testing.T) {
 			target:   make([]string, 0),
 			expected: []string{"hello", "world"},
 		},
+		"list-null-to-go-slice": {
+			typ: types.ListType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, nil),
+			target:   make([]string, 0),
+			expected: nil,
+		},
+		"list-empty-to-go-slice": {
+			typ: types.ListType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{}),
+			target:   nil,
+			expected: []string{},
+		},
 		"set-to-go-slice": {
 			typ: types.SetType{ElemType: types.StringType},
 			value: tftypes.NewValue(tftypes.Set{
@@ -50,6 +66,22 @@ func TestInto_Slices(t *testing.T) {
 			target:   make([]string, 0),
 			expected: []string{"hello", "world"},
 		},
+		"set-null-to-go-slice": {
+			typ: types.SetType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.Set{
+				ElementType: tftypes.String,
+			}, nil),
+			target:   make([]string, 0),
+			expected: nil,
+		},
+		"set-empty-to-go-slice": {
+			typ: types.SetType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.Set{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{}),
+			target:   nil,
+			expected: []string{},
+		},
 		"tuple-to-go-slice": {
 			typ: types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
 			value: tftypes.NewValue(tftypes.Tuple{
@@ -142,9 +174,10 @@ func TestInto_Slices(t *testing.T) {
 				diag.WithPath(
 					path.Empty(),
 					refl.DiagIntoIncompatibleType{
-						Val:        tftypes.NewValue(tftypes.String, "hello"),
-						TargetType: reflect.TypeOf([]string{}),
-						Err:        errors.New("can't unmarshal tftypes.String into *[]tftypes.Value expected []tftypes.Value"),
+						Val:           tftypes.NewValue(tftypes.String, "hello"),
+						TargetType:    reflect.TypeOf([]string{}),
+						Err:           errors.New("can't unmarshal tftypes.String into *[]tftypes.Value expected []tftypes.Value"),
+						SuggestedType: "[]string or basetypes.ListValue",
 					},
 				),
 			},
@@ -171,3 +204,194 @@ func TestInto_Slices(t *testing.T) {
 		})
 	}
 }
+
+func TestInto_Maps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		value         tftypes.Value
+		target        map[string]string
+		expected      map[string]string
+		expectedDiags diag.Diagnostics
+	}{
+		"map-to-go-map": {
+			typ: types.MapType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"key1": tftypes.NewValue(tftypes.String, "hello"),
+				"key2": tftypes.NewValue(tftypes.String, "world"),
+			}),
+			target: nil,
+			expected: map[string]string{
+				"key1": "hello",
+				"key2": "world",
+			},
+		},
+		"map-null-to-go-map": {
+			typ: types.MapType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, nil),
+			target:   make(map[string]string),
+			expected: nil,
+		},
+		"map-empty-to-go-map": {
+			typ: types.MapType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{}),
+			target:   nil,
+			expected: map[string]string{},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := refl.Into(context.Background(), testCase.typ, testCase.value, &testCase.target, refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(testCase.target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				for _, d := range diags {
+					t.Logf("%s: %s\n%s\n", d.Severity(), d.Summary(), d.Detail())
+				}
+				t.Errorf("unexpected diagnostics: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInto_Pointers(t *testing.T) {
+	t.Parallel()
+
+	existing := "existing"
+	hello := "hello"
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		value         tftypes.Value
+		target        *string
+		expected      *string
+		expectedDiags diag.Diagnostics
+	}{
+		"null-to-nil": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.String, nil),
+			target:   &existing,
+			expected: nil,
+		},
+		"value-to-non-nil": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.String, "hello"),
+			target:   nil,
+			expected: &hello,
+		},
+		"unknown-error": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			target:   nil,
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values. "+
+						"Pointer types can represent null values as nil, but cannot represent unknown values.\n\n"+
+						"Path: test\nTarget Type: *string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := refl.Into(context.Background(), testCase.typ, testCase.value, &testCase.target, refl.Options{}, path.Root("test"))
+
+			if diff := cmp.Diff(testCase.target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInto_NestedPath(t *testing.T) {
+	t.Parallel()
+
+	type disk struct {
+		Size string `tfsdk:"size"`
+	}
+
+	type config struct {
+		Disk []disk `tfsdk:"disk"`
+	}
+
+	diskType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"size": tftypes.String,
+		},
+	}
+	configType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"disk": tftypes.List{ElementType: diskType},
+		},
+	}
+
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"disk": types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"size": types.StringType,
+					},
+				},
+			},
+		},
+	}
+
+	value := tftypes.NewValue(configType, map[string]tftypes.Value{
+		"disk": tftypes.NewValue(tftypes.List{ElementType: diskType}, []tftypes.Value{
+			tftypes.NewValue(diskType, map[string]tftypes.Value{
+				"size": tftypes.NewValue(tftypes.String, "small"),
+			}),
+			tftypes.NewValue(diskType, map[string]tftypes.Value{
+				"size": tftypes.NewValue(tftypes.String, "medium"),
+			}),
+			tftypes.NewValue(diskType, map[string]tftypes.Value{
+				"size": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		}),
+	})
+
+	var target config
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("config").AtName("disk").AtListIndex(2).AtName("size"),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+				"Path: config.disk[2].size\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+		),
+	}
+
+	diags := refl.Into(context.Background(), typ, value, &target, refl.Options{}, path.Root("config"))
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics: %s", diff)
+	}
+}
@@ -32,9 +32,10 @@ func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.V
 	}
 	if !val.Type().Is(tftypes.Map{}) {
 		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
-			Val:        val,
-			TargetType: target.Type(),
-			Err:        fmt.Errorf("cannot reflect %s into a map, must be a map", val.Type().String()),
+			Val:           val,
+			TargetType:    target.Type(),
+			Err:           fmt.Errorf("cannot reflect %s into a map, must be a map", val.Type().String()),
+			SuggestedType: SuggestedType(ctx, typ),
 		}))
 		return target, diags
 	}
@@ -93,7 +94,9 @@ func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.V
 
 // FromMap returns an attr.Value representing the data contained in `val`.
 // `val` must be a map type with keys that are a string type. The attr.Value
-// will be of the type produced by `typ`.
+// will be of the type produced by `typ`. If the map is nil, the representation
+// of null for `typ` will be returned, otherwise a non-nil map with no elements
+// will return the representation of an empty map for `typ`.
 //
 // It is meant to be called through FromValue, not directly.
 func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
@@ -145,7 +148,7 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 		}
 		tfVal, err := val.ToTerraformValue(ctx)
 		if err != nil {
-			return nil, append(diags, toTerraformValueErrorDiag(err, path))
+			return nil, append(diags, toTerraformValueErrorDiag(err, path.AtMapKey(key.String())))
 		}
 
 		if typeWithValidate, ok := elemType.(xattr.TypeWithValidate); ok {
@@ -8,6 +8,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -46,3 +49,187 @@ func TestReflectMap_string(t *testing.T) {
 		}
 	}
 }
+
+func TestReflectMap_object(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	var m map[string]testStruct
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	result, diags := refl.Map(context.Background(), types.MapType{
+		ElemType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name": types.StringType,
+			},
+		},
+	}, tftypes.NewValue(tftypes.Map{
+		ElementType: objectType,
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "red"),
+		}),
+		"b": tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, nil),
+		}),
+	}), reflect.ValueOf(m), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	reflect.ValueOf(&m).Elem().Set(result)
+
+	expected := map[string]testStruct{
+		"a": {Name: types.StringValue("red")},
+		"b": {Name: types.StringNull()},
+	}
+
+	if diff := cmp.Diff(m, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestReflectMap_list(t *testing.T) {
+	t.Parallel()
+
+	var m map[string][]string
+
+	listType := tftypes.List{
+		ElementType: tftypes.String,
+	}
+
+	result, diags := refl.Map(context.Background(), types.MapType{
+		ElemType: types.ListType{
+			ElemType: types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Map{
+		ElementType: listType,
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(listType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "red"),
+			tftypes.NewValue(tftypes.String, "blue"),
+		}),
+		"b": tftypes.NewValue(listType, []tftypes.Value{}),
+		"c": tftypes.NewValue(listType, nil),
+	}), reflect.ValueOf(m), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	reflect.ValueOf(&m).Elem().Set(result)
+
+	expected := map[string][]string{
+		"a": {"red", "blue"},
+		"b": {},
+		"c": nil,
+	}
+
+	if diff := cmp.Diff(m, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestReflectMap_elementErrorPath(t *testing.T) {
+	t.Parallel()
+
+	var m map[string]string
+
+	_, diags := refl.Map(context.Background(), types.MapType{
+		ElemType: types.StringType,
+	}, tftypes.NewValue(tftypes.Map{
+		ElementType: tftypes.String,
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}), reflect.ValueOf(m), refl.Options{}, path.Root("test"))
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test").AtMapKey("a"),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+				"Path: test[\"a\"]\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics: %s", diff)
+	}
+}
+
+func TestFromMap_object(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	elemType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	actual, diags := refl.FromValue(context.Background(), types.MapType{
+		ElemType: elemType,
+	}, map[string]testStruct{
+		"a": {Name: types.StringValue("red")},
+		"b": {Name: types.StringNull()},
+	}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := types.MapValueMust(elemType, map[string]attr.Value{
+		"a": types.ObjectValueMust(elemType.AttrTypes, map[string]attr.Value{
+			"name": types.StringValue("red"),
+		}),
+		"b": types.ObjectValueMust(elemType.AttrTypes, map[string]attr.Value{
+			"name": types.StringNull(),
+		}),
+	})
+
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestFromMap_list(t *testing.T) {
+	t.Parallel()
+
+	elemType := types.ListType{
+		ElemType: types.StringType,
+	}
+
+	actual, diags := refl.FromValue(context.Background(), types.MapType{
+		ElemType: elemType,
+	}, map[string][]string{
+		"a": {"red", "blue"},
+		"b": {},
+		"c": nil,
+	}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := types.MapValueMust(elemType, map[string]attr.Value{
+		"a": types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("red"),
+			types.StringValue("blue"),
+		}),
+		"b": types.ListValueMust(types.StringType, []attr.Value{}),
+		"c": types.ListNull(types.StringType),
+	})
+
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -34,9 +34,10 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 	err := val.As(&result)
 	if err != nil {
 		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
-			Err:        err,
-			TargetType: target.Type(),
-			Val:        val,
+			Err:           err,
+			TargetType:    target.Type(),
+			Val:           val,
+			SuggestedType: SuggestedType(ctx, typ),
 		}))
 		return target, diags
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,6 +20,9 @@ import (
 // into an attr.Value using the attr.Type supplied. `val` will first be
 // transformed into a tftypes.Value, then passed to `typ`'s ValueFromTerraform
 // method.
+//
+// Nil slices and maps are transformed into null values, while non-nil,
+// zero-length slices and maps are transformed into known empty values.
 func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -40,6 +44,9 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	if bi, ok := val.(*big.Int); ok {
 		return FromBigInt(ctx, typ, bi, path)
 	}
+	if t, ok := val.(time.Time); ok {
+		return FromTime(ctx, typ, t, path)
+	}
 	value := reflect.ValueOf(val)
 	kind := value.Kind()
 	switch kind {
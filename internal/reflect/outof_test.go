@@ -39,6 +39,38 @@ func TestFromValue_go_types(t *testing.T) {
 			value:    new([]string),
 			expected: types.TupleNull([]attr.Type{types.StringType, types.StringType}),
 		},
+		"empty-go-slice-to-list-value": {
+			typ:      types.ListType{ElemType: types.StringType},
+			value:    []string{},
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"empty-go-slice-to-set-value": {
+			typ:      types.SetType{ElemType: types.StringType},
+			value:    []string{},
+			expected: types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+		"nil-go-map-to-map-value": {
+			typ:      types.MapType{ElemType: types.StringType},
+			value:    map[string]string(nil),
+			expected: types.MapNull(types.StringType),
+		},
+		"empty-go-map-to-map-value": {
+			typ:      types.MapType{ElemType: types.StringType},
+			value:    map[string]string{},
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+		"go-map-to-map-value": {
+			typ: types.MapType{ElemType: types.StringType},
+			value: map[string]string{
+				"key1": "hello",
+			},
+			expected: types.MapValueMust(
+				types.StringType,
+				map[string]attr.Value{
+					"key1": types.StringValue("hello"),
+				},
+			),
+		},
 		"go-slice-to-list-value": {
 			typ:   types.ListType{ElemType: types.StringType},
 			value: []string{"hello", "world"},
@@ -116,6 +148,76 @@ func TestFromValue_go_types(t *testing.T) {
 				),
 			},
 		},
+		"go-struct-to-object-value": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"bool":   types.BoolType,
+					"list":   types.ListType{ElemType: types.StringType},
+					"string": types.StringType,
+				},
+			},
+			value: struct {
+				Bool   bool     `tfsdk:"bool"`
+				List   []string `tfsdk:"list"`
+				String string   `tfsdk:"string"`
+			}{
+				Bool:   true,
+				List:   []string{"hello"},
+				String: "world",
+			},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"bool":   types.BoolType,
+					"list":   types.ListType{ElemType: types.StringType},
+					"string": types.StringType,
+				},
+				map[string]attr.Value{
+					"bool":   types.BoolValue(true),
+					"list":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("hello")}),
+					"string": types.StringValue("world"),
+				},
+			),
+		},
+		"go-struct-slice-to-list-value": {
+			typ: types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"string": types.StringType,
+					},
+				},
+			},
+			value: []struct {
+				String string `tfsdk:"string"`
+			}{
+				{String: "hello"},
+				{String: "world"},
+			},
+			expected: types.ListValueMust(
+				types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"string": types.StringType,
+					},
+				},
+				[]attr.Value{
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"string": types.StringType,
+						},
+						map[string]attr.Value{
+							"string": types.StringValue("hello"),
+						},
+					),
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"string": types.StringType,
+						},
+						map[string]attr.Value{
+							"string": types.StringValue("world"),
+						},
+					),
+				},
+			),
+		},
 		"go-slice-incompatible-type": {
 			typ:   types.StringType,
 			value: []string{"hello", "world"},
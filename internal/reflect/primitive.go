@@ -28,9 +28,10 @@ func Primitive(ctx context.Context, typ attr.Type, val tftypes.Value, target ref
 		err := val.As(&b)
 		if err != nil {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
-				Val:        val,
-				TargetType: target.Type(),
-				Err:        err,
+				Val:           val,
+				TargetType:    target.Type(),
+				Err:           err,
+				SuggestedType: SuggestedType(ctx, typ),
 			}))
 			return target, diags
 		}
@@ -40,9 +41,10 @@ func Primitive(ctx context.Context, typ attr.Type, val tftypes.Value, target ref
 		err := val.As(&s)
 		if err != nil {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
-				Val:        val,
-				TargetType: target.Type(),
-				Err:        err,
+				Val:           val,
+				TargetType:    target.Type(),
+				Err:           err,
+				SuggestedType: SuggestedType(ctx, typ),
 			}))
 			return target, diags
 		}
@@ -36,9 +36,10 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 	err := val.As(&values)
 	if err != nil {
 		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
-			Val:        val,
-			TargetType: target.Type(),
-			Err:        err,
+			Val:           val,
+			TargetType:    target.Type(),
+			Err:           err,
+			SuggestedType: SuggestedType(ctx, typ),
 		}))
 		return target, diags
 	}
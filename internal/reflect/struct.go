@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -44,9 +45,10 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	}
 	if !object.Type().Is(tftypes.Object{}) {
 		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
-			Val:        object,
-			TargetType: target.Type(),
-			Err:        fmt.Errorf("cannot reflect %s into a struct, must be an object", object.Type().String()),
+			Val:           object,
+			TargetType:    target.Type(),
+			Err:           fmt.Errorf("cannot reflect %s into a struct, must be an object", object.Type().String()),
+			SuggestedType: SuggestedType(ctx, typ),
 		}))
 		return target, diags
 	}
@@ -116,10 +118,18 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 
 	attrTypes := attrsType.AttributeTypes()
 
+	// sort the field names so diagnostics are returned in a consistent
+	// order
+	fieldNames := make([]string, 0, len(targetFields))
+	for field := range targetFields {
+		fieldNames = append(fieldNames, field)
+	}
+	sort.Strings(fieldNames)
+
 	// now that we know they match perfectly, fill the struct with the
 	// values in the object
 	result := reflect.New(target.Type()).Elem()
-	for field, structFieldPos := range targetFields {
+	for _, field := range fieldNames {
 		attrType, ok := attrTypes[field]
 		if !ok {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
@@ -129,15 +139,22 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			}))
 			return target, diags
 		}
-		structField := result.Field(structFieldPos)
+		structField := result.FieldByIndex(targetFields[field])
 		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field], structField, opts, path.AtName(field))
 		diags.Append(fieldValDiags...)
 
-		if diags.HasError() {
-			return target, diags
+		// continue with the remaining fields so all field errors are
+		// returned at once
+		if fieldValDiags.HasError() {
+			continue
 		}
 		structField.Set(fieldVal)
 	}
+
+	if diags.HasError() {
+		return target, diags
+	}
+
 	return result, diags
 }
 
@@ -210,9 +227,9 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		return nil, diags
 	}
 
-	for name, fieldNo := range targetFields {
+	for name, fieldIndex := range targetFields {
 		path := path.AtName(name)
-		fieldValue := val.Field(fieldNo)
+		fieldValue := val.FieldByIndex(fieldIndex)
 
 		attrVal, attrValDiags := FromValue(ctx, attrTypes[name], fieldValue.Interface(), path)
 		diags.Append(attrValDiags...)
@@ -30,12 +30,15 @@ func TestNewStruct_errors(t *testing.T) {
 		targetVal     reflect.Value
 		expectedError error
 		expectedDiags diag.Diagnostics
+
+		expectedSuggestedType string
 	}{
 		"not-an-object": {
-			typ:           types.StringType,
-			objVal:        tftypes.NewValue(tftypes.String, "hello"),
-			targetVal:     reflect.ValueOf(struct{}{}),
-			expectedError: fmt.Errorf("cannot reflect %s into a struct, must be an object", tftypes.String),
+			typ:                   types.StringType,
+			objVal:                tftypes.NewValue(tftypes.String, "hello"),
+			targetVal:             reflect.ValueOf(struct{}{}),
+			expectedError:         fmt.Errorf("cannot reflect %s into a struct, must be an object", tftypes.String),
+			expectedSuggestedType: "string or basetypes.StringValue",
 		},
 		"not-a-struct": {
 			typ: types.ObjectType{
@@ -114,7 +117,7 @@ func TestNewStruct_errors(t *testing.T) {
 			}{}),
 			expectedError: fmt.Errorf(
 				"error retrieving field names from struct tags: %w",
-				errors.New(`: need a struct tag for "tfsdk" on ExportedAndUntagged`)),
+				errors.New(`: need a struct tag for "tfsdk" on ExportedAndUntagged, or a tfsdk:"-" struct tag to ignore the field`)),
 		},
 		"struct-has-invalid-tags": {
 			typ: types.ObjectType{
@@ -167,9 +170,10 @@ func TestNewStruct_errors(t *testing.T) {
 
 			expectedDiags := diag.Diagnostics{
 				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
-					Err:        testCase.expectedError,
-					TargetType: testCase.targetVal.Type(),
-					Val:        testCase.objVal,
+					Err:           testCase.expectedError,
+					TargetType:    testCase.targetVal.Type(),
+					Val:           testCase.objVal,
+					SuggestedType: testCase.expectedSuggestedType,
 				}),
 			}
 
@@ -185,6 +189,58 @@ func TestNewStruct_errors(t *testing.T) {
 	}
 }
 
+func TestNewStruct_multipleFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	objVal := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+			"b": tftypes.String,
+			"c": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "hello"),
+		"b": tftypes.NewValue(tftypes.String, "world"),
+		"c": tftypes.NewValue(tftypes.String, "valid"),
+	})
+
+	var target struct {
+		A bool   `tfsdk:"a"`
+		B int64  `tfsdk:"b"`
+		C string `tfsdk:"c"`
+	}
+
+	expectedDiags := diag.Diagnostics{
+		diag.WithPath(path.Root("a"), refl.DiagIntoIncompatibleType{
+			Val:           tftypes.NewValue(tftypes.String, "hello"),
+			TargetType:    reflect.TypeOf(false),
+			Err:           errors.New("can't unmarshal tftypes.String into *bool, expected boolean"),
+			SuggestedType: "string or basetypes.StringValue",
+		}),
+		diag.WithPath(path.Root("b"), refl.DiagIntoIncompatibleType{
+			Val:           tftypes.NewValue(tftypes.String, "world"),
+			TargetType:    reflect.TypeOf(int64(0)),
+			Err:           errors.New("can't unmarshal tftypes.String into *big.Float, expected *big.Float"),
+			SuggestedType: "string or basetypes.StringValue",
+		}),
+	}
+
+	_, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a": types.StringType,
+			"b": types.StringType,
+			"c": types.StringType,
+		},
+	}, objVal, reflect.ValueOf(target), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		for _, d := range diags {
+			t.Logf("%s: %s\n%s\n", d.Severity(), d.Summary(), d.Detail())
+		}
+		t.Errorf("unexpected diagnostics: %s", diff)
+	}
+}
+
 func TestNewStruct_primitives(t *testing.T) {
 	t.Parallel()
 
@@ -225,6 +281,43 @@ func TestNewStruct_primitives(t *testing.T) {
 	}
 }
 
+func TestNewStruct_embedded(t *testing.T) {
+	t.Parallel()
+
+	type Embedded struct {
+		B string `tfsdk:"b"`
+	}
+
+	var s struct {
+		A string `tfsdk:"a"`
+		Embedded
+	}
+	result, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a": types.StringType,
+			"b": types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+			"b": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "hello"),
+		"b": tftypes.NewValue(tftypes.String, "world"),
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+	if s.A != "hello" {
+		t.Errorf("Expected s.A to be %q, was %q", "hello", s.A)
+	}
+	if s.B != "world" {
+		t.Errorf("Expected s.B to be %q, was %q", "world", s.B)
+	}
+}
+
 func TestNewStruct_complex(t *testing.T) {
 	t.Parallel()
 
@@ -607,6 +700,80 @@ func TestFromStruct_primitives(t *testing.T) {
 	}
 }
 
+func TestFromStruct_embedded(t *testing.T) {
+	t.Parallel()
+
+	type Embedded struct {
+		Age int `tfsdk:"age"`
+	}
+	type disk struct {
+		Name string `tfsdk:"name"`
+		Embedded
+	}
+	disk1 := disk{
+		Name: "myfirstdisk",
+		Embedded: Embedded{
+			Age: 30,
+		},
+	}
+
+	actualVal, diags := refl.FromStruct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"age":  types.NumberType,
+		},
+	}, reflect.ValueOf(disk1), path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expectedVal := types.ObjectValueMust(
+		map[string]attr.Type{
+			"name": types.StringType,
+			"age":  types.NumberType,
+		},
+		map[string]attr.Value{
+			"name": types.StringValue("myfirstdisk"),
+			"age":  types.NumberValue(big.NewFloat(30)),
+		},
+	)
+
+	if diff := cmp.Diff(expectedVal, actualVal); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromStruct_embedded_collision(t *testing.T) {
+	t.Parallel()
+
+	type Embedded struct {
+		Name string `tfsdk:"name"`
+	}
+	type disk struct {
+		Name string `tfsdk:"name"`
+		Embedded
+	}
+
+	_, diags := refl.FromStruct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}, reflect.ValueOf(disk{}), path.Empty())
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Empty(),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"error retrieving field names from struct tags: name: can't use field name for both Name and Embedded.Name",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestFromStruct_complex(t *testing.T) {
 	t.Parallel()
 
@@ -1010,7 +1177,7 @@ func TestFromStruct_errors(t *testing.T) {
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to convert from struct value. "+
 						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"error retrieving field names from struct tags: test: need a struct tag for \"tfsdk\" on ExportedAndUntagged",
+						"error retrieving field names from struct tags: test: need a struct tag for \"tfsdk\" on ExportedAndUntagged, or a tfsdk:\"-\" struct tag to ignore the field",
 				),
 			},
 		},
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SuggestedType returns guidance for which Go types can hold values of the
+// given attr.Type, such as "[]string or basetypes.ListValue". It is intended
+// for diagnostics when the target type does not match the schema.
+func SuggestedType(ctx context.Context, typ attr.Type) string {
+	return fmt.Sprintf("%s or %s", suggestedGoType(ctx, typ), reflect.TypeOf(typ.ValueType(ctx)))
+}
+
+// suggestedGoType returns the Go type which most naturally holds values of the
+// given attr.Type, recursing into collection element types.
+func suggestedGoType(ctx context.Context, typ attr.Type) string {
+	switch t := typ.(type) {
+	case attr.TypeWithElementType:
+		if typ.TerraformType(ctx).Is(tftypes.Map{}) {
+			return "map[string]" + suggestedGoType(ctx, t.ElementType())
+		}
+
+		return "[]" + suggestedGoType(ctx, t.ElementType())
+	case attr.TypeWithAttributeTypes:
+		return "struct"
+	}
+
+	tfType := typ.TerraformType(ctx)
+
+	switch {
+	case tfType.Is(tftypes.Bool):
+		return "bool"
+	case tfType.Is(tftypes.String):
+		return "string"
+	case tfType.Is(tftypes.Number):
+		// The value types do not need to be imported to detect their
+		// methods, which also detects custom types embedding them.
+		switch typ.ValueType(ctx).(type) {
+		case interface{ ValueInt64() int64 }:
+			return "int64"
+		case interface{ ValueFloat64() float64 }:
+			return "float64"
+		}
+
+		return "*big.Float"
+	}
+
+	return reflect.TypeOf(typ.ValueType(ctx)).String()
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSuggestedType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		expected string
+	}{
+		"bool": {
+			typ:      types.BoolType,
+			expected: "bool or basetypes.BoolValue",
+		},
+		"float64": {
+			typ:      types.Float64Type,
+			expected: "float64 or basetypes.Float64Value",
+		},
+		"int64": {
+			typ:      types.Int64Type,
+			expected: "int64 or basetypes.Int64Value",
+		},
+		"number": {
+			typ:      types.NumberType,
+			expected: "*big.Float or basetypes.NumberValue",
+		},
+		"string": {
+			typ:      types.StringType,
+			expected: "string or basetypes.StringValue",
+		},
+		"list-string": {
+			typ:      types.ListType{ElemType: types.StringType},
+			expected: "[]string or basetypes.ListValue",
+		},
+		"map-int64": {
+			typ:      types.MapType{ElemType: types.Int64Type},
+			expected: "map[string]int64 or basetypes.MapValue",
+		},
+		"object": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test": types.StringType,
+				},
+			},
+			expected: "struct or basetypes.ObjectValue",
+		},
+		"set-list-bool": {
+			typ:      types.SetType{ElemType: types.ListType{ElemType: types.BoolType}},
+			expected: "[][]bool or basetypes.SetValue",
+		},
+		"set-object": {
+			typ: types.SetType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test": types.StringType,
+					},
+				},
+			},
+			expected: "[]struct or basetypes.SetValue",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := refl.SuggestedType(context.Background(), testCase.typ)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInto_SuggestedTypeDetail(t *testing.T) {
+	t.Parallel()
+
+	var target string
+
+	diags := refl.Into(
+		context.Background(),
+		types.ListType{ElemType: types.StringType},
+		tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "hello"),
+		}),
+		&target,
+		refl.Options{},
+		path.Root("test"),
+	)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %v", diags)
+	}
+
+	expected := "An unexpected error was encountered trying to convert tftypes.Value into string. This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+		"can't unmarshal tftypes.List[tftypes.String] into *string, expected string\n\n" +
+		"Suggested Type: []string or basetypes.ListValue"
+
+	if diff := cmp.Diff(diags[0].Detail(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// timeType is the reflect.Type of time.Time, which is a struct but is handled
+// as an RFC3339 formatted string.
+var timeType = reflect.TypeOf(time.Time{})
+
+// Time builds a time.Time from a string `val` in RFC3339 format.
+//
+// It is meant to be called through `Into`, not directly.
+func Time(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	err := val.As(&s)
+
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"A string value was provided that is not a valid RFC3339 timestamp.\n\n"+
+				fmt.Sprintf("Path: %s\nGiven Value: %q\nError: %s", path, s, err),
+		)
+		return target, diags
+	}
+
+	return reflect.ValueOf(t).Convert(target.Type()), nil
+}
+
+// FromTime returns an attr.Value as produced by `typ` from a time.Time, which
+// is formatted as an RFC3339 string, including any fractional seconds.
+//
+// It is meant to be called through FromValue, not directly.
+func FromTime(ctx context.Context, typ attr.Type, val time.Time, path path.Path) (attr.Value, diag.Diagnostics) {
+	return FromString(ctx, typ, val.Format(time.RFC3339Nano), path)
+}
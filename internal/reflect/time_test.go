@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestInto_time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		opts          refl.Options
+		expected      time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			val:      tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"valid-fractional-seconds": {
+			val:      tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC),
+		},
+		"invalid": {
+			val: tftypes.NewValue(tftypes.String, "2006-01-02"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"A string value was provided that is not a valid RFC3339 timestamp.\n\n"+
+						"Path: test\n"+
+						"Given Value: \"2006-01-02\"\n"+
+						"Error: parsing time \"2006-01-02\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"\" as \"T\"",
+				),
+			},
+		},
+		"null": {
+			val: tftypes.NewValue(tftypes.String, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: test\nTarget Type: time.Time\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *time.Time",
+				),
+			},
+		},
+		"null-UnhandledNullAsEmpty": {
+			val: tftypes.NewValue(tftypes.String, nil),
+			opts: refl.Options{
+				UnhandledNullAsEmpty: true,
+			},
+			expected: time.Time{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got time.Time
+
+			diags := refl.Into(context.Background(), types.StringType, testCase.val, &got, testCase.opts, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestInto_timePointer(t *testing.T) {
+	t.Parallel()
+
+	testTime := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		val      tftypes.Value
+		expected *time.Time
+	}{
+		"valid": {
+			val:      tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			expected: &testTime,
+		},
+		"null": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *time.Time
+
+			diags := refl.Into(context.Background(), types.StringType, testCase.val, &got, refl.Options{}, path.Root("test"))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_time(t *testing.T) {
+	t.Parallel()
+
+	testTime := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		val      any
+		expected attr.Value
+	}{
+		"time": {
+			val:      time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			expected: types.StringValue("2006-01-02T15:04:05Z"),
+		},
+		"time-fractional-seconds": {
+			val:      time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.FixedZone("test", -7*60*60)),
+			expected: types.StringValue("2006-01-02T15:04:05.123-07:00"),
+		},
+		"time-pointer": {
+			val:      &testTime,
+			expected: types.StringValue("2006-01-02T15:04:05Z"),
+		},
+		"time-pointer-nil": {
+			val:      (*time.Time)(nil),
+			expected: types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), types.StringType, testCase.val, path.Root("test"))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
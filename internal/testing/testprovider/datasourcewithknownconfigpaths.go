@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ datasource.DataSource = &DataSourceWithKnownConfigPaths{}
+var _ datasource.DataSourceWithKnownConfigPaths = &DataSourceWithKnownConfigPaths{}
+
+// Declarative datasource.DataSourceWithKnownConfigPaths for unit testing.
+type DataSourceWithKnownConfigPaths struct {
+	*DataSource
+
+	// DataSourceWithKnownConfigPaths interface methods
+	KnownConfigPathsMethod func(context.Context) path.Expressions
+}
+
+// KnownConfigPaths satisfies the datasource.DataSourceWithKnownConfigPaths interface.
+func (p *DataSourceWithKnownConfigPaths) KnownConfigPaths(ctx context.Context) path.Expressions {
+	if p.KnownConfigPathsMethod == nil {
+		return nil
+	}
+
+	return p.KnownConfigPathsMethod(ctx)
+}
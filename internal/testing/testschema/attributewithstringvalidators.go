@@ -12,12 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwxschema.AttributeWithStringValidators = AttributeWithStringValidators{}
+var (
+	_ fwschema.AttributeWithLogSensitive      = AttributeWithStringValidators{}
+	_ fwxschema.AttributeWithStringValidators = AttributeWithStringValidators{}
+)
 
 type AttributeWithStringValidators struct {
 	Computed            bool
 	DeprecationMessage  string
 	Description         string
+	LogSensitive        bool
 	MarkdownDescription string
 	Optional            bool
 	Required            bool
@@ -66,6 +70,11 @@ func (a AttributeWithStringValidators) IsComputed() bool {
 	return a.Computed
 }
 
+// IsLogSensitive satisfies the fwschema.AttributeWithLogSensitive interface.
+func (a AttributeWithStringValidators) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // IsOptional satisfies the fwschema.Attribute interface.
 func (a AttributeWithStringValidators) IsOptional() bool {
 	return a.Optional
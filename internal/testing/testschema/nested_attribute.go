@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-var _ fwschema.NestedAttribute = NestedAttribute{}
+var _ fwschema.NestedAttributeWithItemsLimits = NestedAttribute{}
 
 type NestedAttribute struct {
 	Computed            bool
@@ -22,6 +22,8 @@ type NestedAttribute struct {
 	DeprecationMessage  string
 	Description         string
 	MarkdownDescription string
+	MaxItems            int64
+	MinItems            int64
 	NestedObject        fwschema.NestedAttributeObject
 	NestingMode         fwschema.NestingMode
 	Optional            bool
@@ -102,6 +104,16 @@ func (a NestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMaxItems satisfies the fwschema.NestedAttributeWithItemsLimits interface.
+func (a NestedAttribute) GetMaxItems() int64 {
+	return a.MaxItems
+}
+
+// GetMinItems satisfies the fwschema.NestedAttributeWithItemsLimits interface.
+func (a NestedAttribute) GetMinItems() int64 {
+	return a.MinItems
+}
+
 // GetNestedObject satisfies the fwschema.NestedAttribute interface.
 func (a NestedAttribute) GetNestedObject() fwschema.NestedAttributeObject {
 	return a.NestedObject
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = StringTypeWithTrimmedSemanticEquals{}
+	_ basetypes.StringValuableWithSemanticEquals = StringValueWithTrimmedSemanticEquals{}
+)
+
+// StringTypeWithTrimmedSemanticEquals is a StringType associated with
+// StringValueWithTrimmedSemanticEquals, which considers values semantically
+// equal when they match after trimming leading and trailing whitespace.
+type StringTypeWithTrimmedSemanticEquals struct {
+	basetypes.StringType
+}
+
+func (t StringTypeWithTrimmedSemanticEquals) Equal(o attr.Type) bool {
+	other, ok := o.(StringTypeWithTrimmedSemanticEquals)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t StringTypeWithTrimmedSemanticEquals) String() string {
+	return "StringTypeWithTrimmedSemanticEquals"
+}
+
+func (t StringTypeWithTrimmedSemanticEquals) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return StringValueWithTrimmedSemanticEquals{StringValue: in}, nil
+}
+
+func (t StringTypeWithTrimmedSemanticEquals) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t StringTypeWithTrimmedSemanticEquals) ValueType(ctx context.Context) attr.Value {
+	return StringValueWithTrimmedSemanticEquals{}
+}
+
+type StringValueWithTrimmedSemanticEquals struct {
+	basetypes.StringValue
+}
+
+func (v StringValueWithTrimmedSemanticEquals) Equal(o attr.Value) bool {
+	other, ok := o.(StringValueWithTrimmedSemanticEquals)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v StringValueWithTrimmedSemanticEquals) StringSemanticEquals(ctx context.Context, otherV basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	other, ok := otherV.(StringValueWithTrimmedSemanticEquals)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T", v, otherV),
+		)
+
+		return false, diags
+	}
+
+	return strings.TrimSpace(v.ValueString()) == strings.TrimSpace(other.ValueString()), diags
+}
+
+func (v StringValueWithTrimmedSemanticEquals) Type(ctx context.Context) attr.Type {
+	return StringTypeWithTrimmedSemanticEquals{}
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -23,13 +24,14 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 		TypeName: name,
 	}
 
-	if b.GetDescription() != "" {
-		schemaNestedBlock.Block.Description = b.GetDescription()
+	description, descriptionKind := schema.EffectiveDescription(b)
+
+	if description != "" {
+		schemaNestedBlock.Block.Description = description
 		schemaNestedBlock.Block.DescriptionKind = tfprotov5.StringKindPlain
 	}
 
-	if b.GetMarkdownDescription() != "" {
-		schemaNestedBlock.Block.Description = b.GetMarkdownDescription()
+	if descriptionKind == schema.DescriptionKindMarkdown {
 		schemaNestedBlock.Block.DescriptionKind = tfprotov5.StringKindMarkdown
 	}
 
@@ -46,8 +48,19 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 	}
 
 	nestedBlockObject := b.GetNestedObject()
+	nestedAttributes := nestedBlockObject.GetAttributes()
+	nestedBlocks := nestedBlockObject.GetBlocks()
+
+	// Preallocate to prevent repeated slice growth. Empty slices remain nil.
+	if len(nestedAttributes) > 0 {
+		schemaNestedBlock.Block.Attributes = make([]*tfprotov5.SchemaAttribute, 0, len(nestedAttributes))
+	}
+
+	if len(nestedBlocks) > 0 {
+		schemaNestedBlock.Block.BlockTypes = make([]*tfprotov5.SchemaNestedBlock, 0, len(nestedBlocks))
+	}
 
-	for attrName, attr := range nestedBlockObject.GetAttributes() {
+	for attrName, attr := range nestedAttributes {
 		attrPath := path.WithAttributeName(attrName)
 		attrProto5, err := SchemaAttribute(ctx, attrName, attrPath, attr)
 
@@ -58,7 +71,7 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 		schemaNestedBlock.Block.Attributes = append(schemaNestedBlock.Block.Attributes, attrProto5)
 	}
 
-	for blockName, block := range nestedBlockObject.GetBlocks() {
+	for blockName, block := range nestedBlocks {
 		blockPath := path.WithAttributeName(blockName)
 		blockProto5, err := Block(ctx, blockName, blockPath, block)
 
@@ -25,10 +25,18 @@ func DiagnosticSeverity(s diag.Severity) tfprotov5.DiagnosticSeverity {
 }
 
 // Diagnostics converts the diagnostics into the tfprotov5 collection type.
+// Duplicate diagnostics, based on severity, summary, detail, and attribute
+// path, are removed while preserving the order of first occurrence.
 func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov5.Diagnostic {
 	var results []*tfprotov5.Diagnostic
 
-	for _, diagnostic := range diagnostics {
+	// Diagnostics can be added to a collection without the Append method,
+	// which would otherwise skip duplicates, such as with the built-in append.
+	var deduplicated diag.Diagnostics
+
+	deduplicated.Append(diagnostics...)
+
+	for _, diagnostic := range deduplicated {
 		tfprotov5Diagnostic := &tfprotov5.Diagnostic{
 			Detail:   diagnostic.Detail(),
 			Severity: DiagnosticSeverity(diagnostic.Severity()),
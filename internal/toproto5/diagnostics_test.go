@@ -93,6 +93,40 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"Diagnostic-duplicate": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("other"), "one summary", "one detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+			},
+			expected: []*tfprotov5.Diagnostic{
+				{
+					Detail:   "one detail",
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+				{
+					Detail:   "one detail",
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "one summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "one detail",
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "one summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("other"),
+					Detail:    "one detail",
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "one summary",
+				},
+			},
+		},
 		"DiagnosticWithPath": {
 			diags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(path.Empty(), "one summary", "one detail"),
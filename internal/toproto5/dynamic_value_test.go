@@ -1247,3 +1247,77 @@ func TestDynamicValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicValueJSON(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		fw            *fwschemadata.Data
+		expected      *tfprotov5.DynamicValue
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			fw:       nil,
+			expected: nil,
+		},
+		"known": {
+			fw: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+			},
+			expected: &tfprotov5.DynamicValue{
+				JSON: []byte(`{"test":"test-value"}`),
+			},
+		},
+		"unknown": {
+			fw: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			expected: DynamicValueMust(
+				tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := toproto5.DynamicValueJSON(context.Background(), testCase.fw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
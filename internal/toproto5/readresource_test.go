@@ -183,3 +183,81 @@ func TestReadResourceResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestReadResourceResponseJSON(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_attribute": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testProto5Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProto5UnknownValue := tftypes.NewValue(testProto5Type, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	testProto5UnknownDynamicValue, err := tfprotov5.NewDynamicValue(testProto5Type, testProto5UnknownValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
+	testCases := map[string]struct {
+		input    *fwserver.ReadResourceResponse
+		expected *tfprotov5.ReadResourceResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"newstate": {
+			input: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testProto5Type, map[string]tftypes.Value{
+						"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+			expected: &tfprotov5.ReadResourceResponse{
+				NewState: &tfprotov5.DynamicValue{
+					JSON: []byte(`{"test_attribute":"test-value"}`),
+				},
+			},
+		},
+		"newstate-unknown": {
+			input: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testProto5UnknownValue,
+					Schema: testSchema,
+				},
+			},
+			expected: &tfprotov5.ReadResourceResponse{
+				NewState: &testProto5UnknownDynamicValue,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.ReadResourceResponseJSON(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
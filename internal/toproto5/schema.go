@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -25,8 +26,23 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov5.Schema, error) {
 	var attrs []*tfprotov5.SchemaAttribute
 	var blocks []*tfprotov5.SchemaNestedBlock
 
-	for name, attr := range s.GetAttributes() {
-		a, err := SchemaAttribute(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), attr)
+	// Preallocate to prevent repeated slice growth for large schemas. Empty
+	// slices remain nil.
+	schemaAttributes := s.GetAttributes()
+	schemaBlocks := s.GetBlocks()
+
+	if len(schemaAttributes) > 0 {
+		attrs = make([]*tfprotov5.SchemaAttribute, 0, len(schemaAttributes))
+	}
+
+	if len(schemaBlocks) > 0 {
+		blocks = make([]*tfprotov5.SchemaNestedBlock, 0, len(schemaBlocks))
+	}
+
+	rootPath := tftypes.NewAttributePath()
+
+	for name, attr := range schemaAttributes {
+		a, err := SchemaAttribute(ctx, name, rootPath.WithAttributeName(name), attr)
 
 		if err != nil {
 			return nil, err
@@ -35,8 +51,8 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov5.Schema, error) {
 		attrs = append(attrs, a)
 	}
 
-	for name, block := range s.GetBlocks() {
-		proto5, err := Block(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), block)
+	for name, block := range schemaBlocks {
+		proto5, err := Block(ctx, name, rootPath.WithAttributeName(name), block)
 
 		if err != nil {
 			return nil, err
@@ -77,13 +93,14 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov5.Schema, error) {
 		Deprecated: s.GetDeprecationMessage() != "",
 	}
 
-	if s.GetDescription() != "" {
-		result.Block.Description = s.GetDescription()
+	description, descriptionKind := schema.EffectiveDescription(s)
+
+	if description != "" {
+		result.Block.Description = description
 		result.Block.DescriptionKind = tfprotov5.StringKindPlain
 	}
 
-	if s.GetMarkdownDescription() != "" {
-		result.Block.Description = s.GetMarkdownDescription()
+	if descriptionKind == schema.DescriptionKindMarkdown {
 		result.Block.DescriptionKind = tfprotov5.StringKindMarkdown
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -19,7 +20,9 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		return nil, path.NewErrorf("protocol version 5 cannot have Attributes set")
 	}
 
-	if a.GetType() == nil {
+	attrType := a.GetType()
+
+	if attrType == nil {
 		return nil, path.NewErrorf("must have Type set")
 	}
 
@@ -33,20 +36,21 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		Optional:  a.IsOptional(),
 		Computed:  a.IsComputed(),
 		Sensitive: a.IsSensitive(),
-		Type:      a.GetType().TerraformType(ctx),
+		Type:      attrType.TerraformType(ctx),
 	}
 
 	if a.GetDeprecationMessage() != "" {
 		schemaAttribute.Deprecated = true
 	}
 
-	if a.GetDescription() != "" {
-		schemaAttribute.Description = a.GetDescription()
+	description, descriptionKind := schema.EffectiveDescription(a)
+
+	if description != "" {
+		schemaAttribute.Description = description
 		schemaAttribute.DescriptionKind = tfprotov5.StringKindPlain
 	}
 
-	if a.GetMarkdownDescription() != "" {
-		schemaAttribute.Description = a.GetMarkdownDescription()
+	if descriptionKind == schema.DescriptionKindMarkdown {
 		schemaAttribute.DescriptionKind = tfprotov5.StringKindMarkdown
 	}
 
@@ -17,6 +17,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func BenchmarkSchemaAttribute(b *testing.B) {
+	ctx := context.Background()
+	testAttribute := testschema.Attribute{
+		Description: "test description",
+		Optional:    true,
+		Type:        types.ListType{ElemType: types.StringType},
+	}
+	testPath := tftypes.NewAttributePath().WithAttributeName("test")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, err := toproto5.SchemaAttribute(ctx, "test", testPath, testAttribute)
+
+		if err != nil {
+			b.Fatalf("unexpected SchemaAttribute error: %s", err)
+		}
+	}
+}
+
 func TestSchemaAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -262,6 +283,19 @@ func TestSchemaAttribute(t *testing.T) {
 				Sensitive: true,
 			},
 		},
+		"log-sensitive": {
+			name: "string",
+			attr: testschema.AttributeWithStringValidators{
+				Optional:     true,
+				LogSensitive: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaAttribute{
+				Name:     "string",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+		},
 		"nested-attr-single": {
 			name: "single_nested",
 			attr: testschema.NestedAttribute{
@@ -5,6 +5,9 @@ package toproto5_test
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -17,6 +20,64 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func BenchmarkSchema100(b *testing.B) {
+	benchmarkSchema(b, 100)
+}
+
+func BenchmarkSchema1000(b *testing.B) {
+	benchmarkSchema(b, 1000)
+}
+
+func benchmarkSchema(b *testing.B, attributes int) {
+	ctx := context.Background()
+	testSchema := testschema.Schema{
+		Attributes: make(map[string]fwschema.Attribute, attributes),
+		Blocks: map[string]fwschema.Block{
+			"test_block": testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"test_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.BlockNestingModeList,
+			},
+		},
+	}
+
+	for i := 0; i < attributes; i++ {
+		attributeName := "testattr" + strconv.Itoa(i)
+
+		if i%2 == 0 {
+			testSchema.Attributes[attributeName] = testschema.Attribute{
+				Description: "test description",
+				Optional:    true,
+				Type:        types.StringType,
+			}
+
+			continue
+		}
+
+		testSchema.Attributes[attributeName] = testschema.Attribute{
+			Computed: true,
+			Type:     types.ListType{ElemType: types.StringType},
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, err := toproto5.Schema(ctx, testSchema)
+
+		if err != nil {
+			b.Fatalf("unexpected Schema error: %s", err)
+		}
+	}
+}
+
 func TestSchema(t *testing.T) {
 	t.Parallel()
 
@@ -551,3 +612,74 @@ func TestSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_deterministic(t *testing.T) {
+	t.Parallel()
+
+	attributes := make(map[string]fwschema.Attribute)
+	blocks := make(map[string]fwschema.Block)
+	nestedAttributes := make(map[string]fwschema.Attribute)
+
+	for i := 0; i < 20; i++ {
+		attributes[fmt.Sprintf("attribute_%02d", 19-i)] = testschema.Attribute{
+			Type:     types.StringType,
+			Optional: true,
+		}
+		nestedAttributes[fmt.Sprintf("nested_%02d", 19-i)] = testschema.Attribute{
+			Type:     types.StringType,
+			Optional: true,
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		blocks[fmt.Sprintf("block_%02d", 4-i)] = testschema.Block{
+			NestedObject: testschema.NestedBlockObject{
+				Attributes: nestedAttributes,
+			},
+			NestingMode: fwschema.BlockNestingModeList,
+		}
+	}
+
+	schema := testschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	expected, err := toproto5.Schema(context.Background(), schema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !sort.SliceIsSorted(expected.Block.Attributes, func(i, j int) bool {
+		return expected.Block.Attributes[i].Name < expected.Block.Attributes[j].Name
+	}) {
+		t.Errorf("expected sorted attributes")
+	}
+
+	if !sort.SliceIsSorted(expected.Block.BlockTypes, func(i, j int) bool {
+		return expected.Block.BlockTypes[i].TypeName < expected.Block.BlockTypes[j].TypeName
+	}) {
+		t.Errorf("expected sorted blocks")
+	}
+
+	for i := 0; i < 10; i++ {
+		got, err := toproto5.Schema(context.Background(), schema)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for _, block := range got.Block.BlockTypes {
+			if !sort.SliceIsSorted(block.Block.Attributes, func(i, j int) bool {
+				return block.Block.Attributes[i].Name < block.Block.Attributes[j].Name
+			}) {
+				t.Errorf("expected sorted nested block attributes in %s", block.TypeName)
+			}
+		}
+
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Fatalf("unexpected difference between conversions: %s", diff)
+		}
+	}
+}
@@ -12,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
+// stateFunc is the signature of State and StateJSON, which allows response
+// conversion functions to encode state values either way.
+type stateFunc func(context.Context, *tfsdk.State) (*tfprotov5.DynamicValue, diag.Diagnostics)
+
 // State returns the *tfprotov5.DynamicValue for a *tfsdk.State.
 func State(ctx context.Context, fw *tfsdk.State) (*tfprotov5.DynamicValue, diag.Diagnostics) {
 	if fw == nil {
@@ -26,3 +30,20 @@ func State(ctx context.Context, fw *tfsdk.State) (*tfprotov5.DynamicValue, diag.
 
 	return DynamicValue(ctx, data)
 }
+
+// StateJSON returns the JSON encoded *tfprotov5.DynamicValue for a
+// *tfsdk.State, or the MessagePack encoding if the state contains unknown
+// values.
+func StateJSON(ctx context.Context, fw *tfsdk.State) (*tfprotov5.DynamicValue, diag.Diagnostics) {
+	if fw == nil {
+		return nil, nil
+	}
+
+	data := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         fw.Schema,
+		TerraformValue: fw.Raw,
+	}
+
+	return DynamicValueJSON(ctx, data)
+}
@@ -107,3 +107,53 @@ func TestState(t *testing.T) {
 		})
 	}
 }
+
+func TestState_setElementOrder(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_attribute": testschema.Attribute{
+				Required: true,
+				Type:     types.SetType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	testState := func(elements ...string) *tfsdk.State {
+		values := make([]tftypes.Value, 0, len(elements))
+
+		for _, element := range elements {
+			values = append(values, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return &tfsdk.State{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_attribute": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	first, diags := toproto5.State(context.Background(), testState("b", "a", "c"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	second, diags := toproto5.State(context.Background(), testState("c", "b", "a"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(first.MsgPack, second.MsgPack); diff != "" {
+		t.Errorf("expected identical encodings, got difference: %s", diff)
+	}
+}
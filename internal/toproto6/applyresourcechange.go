@@ -14,6 +14,20 @@ import (
 // ApplyResourceChangeResponse returns the *tfprotov6.ApplyResourceChangeResponse
 // equivalent of a *fwserver.ApplyResourceChangeResponse.
 func ApplyResourceChangeResponse(ctx context.Context, fw *fwserver.ApplyResourceChangeResponse) *tfprotov6.ApplyResourceChangeResponse {
+	return applyResourceChangeResponse(ctx, fw, State)
+}
+
+// ApplyResourceChangeResponseJSON returns the *tfprotov6.ApplyResourceChangeResponse
+// equivalent of a *fwserver.ApplyResourceChangeResponse, with JSON encoded state
+// values when possible. This is used when request values are JSON encoded.
+func ApplyResourceChangeResponseJSON(ctx context.Context, fw *fwserver.ApplyResourceChangeResponse) *tfprotov6.ApplyResourceChangeResponse {
+	return applyResourceChangeResponse(ctx, fw, StateJSON)
+}
+
+// applyResourceChangeResponse returns the *tfprotov6.ApplyResourceChangeResponse
+// equivalent of a *fwserver.ApplyResourceChangeResponse, encoding state values
+// with the given function.
+func applyResourceChangeResponse(ctx context.Context, fw *fwserver.ApplyResourceChangeResponse, toState stateFunc) *tfprotov6.ApplyResourceChangeResponse {
 	if fw == nil {
 		return nil
 	}
@@ -22,7 +36,7 @@ func ApplyResourceChangeResponse(ctx context.Context, fw *fwserver.ApplyResource
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
 	}
 
-	newState, diags := State(ctx, fw.NewState)
+	newState, diags := toState(ctx, fw.NewState)
 
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.NewState = newState
@@ -548,6 +548,120 @@ func TestBlock(t *testing.T) {
 				TypeName: "test",
 			},
 		},
+		"nested-attribute-descriptions": {
+			name: "test",
+			block: testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"sub_description": testschema.Attribute{
+							Type:        types.StringType,
+							Optional:    true,
+							Description: "test plain description",
+						},
+						"sub_description_both": testschema.Attribute{
+							Type:                types.StringType,
+							Optional:            true,
+							Description:         "test plain description",
+							MarkdownDescription: "test markdown description",
+						},
+						"sub_markdowndescription": testschema.Attribute{
+							Type:                types.StringType,
+							Optional:            true,
+							MarkdownDescription: "test markdown description",
+						},
+					},
+				},
+				NestingMode: fwschema.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaNestedBlock{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:            "sub_description",
+							Optional:        true,
+							Type:            tftypes.String,
+							Description:     "test plain description",
+							DescriptionKind: tfprotov6.StringKindPlain,
+						},
+						{
+							Name:            "sub_description_both",
+							Optional:        true,
+							Type:            tftypes.String,
+							Description:     "test markdown description",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+						},
+						{
+							Name:            "sub_markdowndescription",
+							Optional:        true,
+							Type:            tftypes.String,
+							Description:     "test markdown description",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+						},
+					},
+				},
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"nested-block-deprecationmessage-and-descriptions": {
+			name: "test",
+			block: testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Blocks: map[string]fwschema.Block{
+						"sub_block_deprecated": testschema.Block{
+							DeprecationMessage: "deprecated, use something else instead",
+							NestingMode:        fwschema.BlockNestingModeSet,
+							NestedObject:       testschema.NestedBlockObject{},
+						},
+						"sub_block_description": testschema.Block{
+							Description:  "test plain description",
+							NestingMode:  fwschema.BlockNestingModeSingle,
+							NestedObject: testschema.NestedBlockObject{},
+						},
+						"sub_block_description_both": testschema.Block{
+							Description:         "test plain description",
+							MarkdownDescription: "test markdown description",
+							NestingMode:         fwschema.BlockNestingModeList,
+							NestedObject:        testschema.NestedBlockObject{},
+						},
+					},
+				},
+				NestingMode: fwschema.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaNestedBlock{
+				Block: &tfprotov6.SchemaBlock{
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							Block: &tfprotov6.SchemaBlock{
+								Deprecated: true,
+							},
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+							TypeName: "sub_block_deprecated",
+						},
+						{
+							Block: &tfprotov6.SchemaBlock{
+								Description:     "test plain description",
+								DescriptionKind: tfprotov6.StringKindPlain,
+							},
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+							TypeName: "sub_block_description",
+						},
+						{
+							Block: &tfprotov6.SchemaBlock{
+								Description:     "test markdown description",
+								DescriptionKind: tfprotov6.StringKindMarkdown,
+							},
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							TypeName: "sub_block_description_both",
+						},
+					},
+				},
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -25,10 +25,18 @@ func DiagnosticSeverity(s diag.Severity) tfprotov6.DiagnosticSeverity {
 }
 
 // Diagnostics converts the diagnostics into the tfprotov6 collection type.
+// Duplicate diagnostics, based on severity, summary, detail, and attribute
+// path, are removed while preserving the order of first occurrence.
 func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov6.Diagnostic {
 	var results []*tfprotov6.Diagnostic
 
-	for _, diagnostic := range diagnostics {
+	// Diagnostics can be added to a collection without the Append method,
+	// which would otherwise skip duplicates, such as with the built-in append.
+	var deduplicated diag.Diagnostics
+
+	deduplicated.Append(diagnostics...)
+
+	for _, diagnostic := range deduplicated {
 		tfprotov6Diagnostic := &tfprotov6.Diagnostic{
 			Detail:   diagnostic.Detail(),
 			Severity: DiagnosticSeverity(diagnostic.Severity()),
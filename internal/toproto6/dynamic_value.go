@@ -19,6 +19,9 @@ import (
 // developers from needing to understand Terraform's differences between
 // block and attribute values where blocks are technically never null, but from
 // a developer perspective this distinction introduces unnecessary complexity.
+//
+// Set elements are also sorted, so the encoding of equal set values does not
+// depend on the order the elements were created in.
 func DynamicValue(ctx context.Context, data *fwschemadata.Data) (*tfprotov6.DynamicValue, diag.Diagnostics) {
 	if data == nil {
 		return nil, nil
@@ -29,6 +32,9 @@ func DynamicValue(ctx context.Context, data *fwschemadata.Data) (*tfprotov6.Dyna
 	// Prevent Terraform core errors for null list/set blocks.
 	diags.Append(data.ReifyNullCollectionBlocks(ctx)...)
 
+	// Encode equal sets identically, regardless of element order.
+	diags.Append(data.SortSetElements(ctx)...)
+
 	proto6, err := tfprotov6.NewDynamicValue(data.Schema.Type().TerraformType(ctx), data.TerraformValue)
 
 	if err != nil {
@@ -43,5 +49,44 @@ func DynamicValue(ctx context.Context, data *fwschemadata.Data) (*tfprotov6.Dyna
 		return nil, diags
 	}
 
-	return &proto6, nil
+	return &proto6, diags
+}
+
+// DynamicValueJSON returns the JSON encoded *tfprotov6.DynamicValue for a
+// given fwschemadata.Data. Terraform accepts either encoding, however JSON can
+// be easier to inspect when debugging. The MessagePack encoding from
+// DynamicValue is returned instead if the data contains unknown values, which
+// cannot be represented in JSON.
+func DynamicValueJSON(ctx context.Context, data *fwschemadata.Data) (*tfprotov6.DynamicValue, diag.Diagnostics) {
+	if data == nil {
+		return nil, nil
+	}
+
+	if !data.TerraformValue.IsFullyKnown() {
+		return DynamicValue(ctx, data)
+	}
+
+	var diags diag.Diagnostics
+
+	// Prevent Terraform core errors for null list/set blocks.
+	diags.Append(data.ReifyNullCollectionBlocks(ctx)...)
+
+	// Encode equal sets identically, regardless of element order.
+	diags.Append(data.SortSetElements(ctx)...)
+
+	proto6JSON, err := data.TerraformValueJSON(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Convert "+data.Description.Title(),
+			"An unexpected error was encountered when converting the "+data.Description.String()+" to the protocol type. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Please report this to the provider developer:\n\n"+
+				"Unable to create JSON DynamicValue: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return &tfprotov6.DynamicValue{JSON: proto6JSON}, diags
 }
@@ -15,6 +15,20 @@ import (
 // PlanResourceChangeResponse returns the *tfprotov6.PlanResourceChangeResponse
 // equivalent of a *fwserver.PlanResourceChangeResponse.
 func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceChangeResponse) *tfprotov6.PlanResourceChangeResponse {
+	return planResourceChangeResponse(ctx, fw, State)
+}
+
+// PlanResourceChangeResponseJSON returns the *tfprotov6.PlanResourceChangeResponse
+// equivalent of a *fwserver.PlanResourceChangeResponse, with JSON encoded state
+// values when possible. This is used when request values are JSON encoded.
+func PlanResourceChangeResponseJSON(ctx context.Context, fw *fwserver.PlanResourceChangeResponse) *tfprotov6.PlanResourceChangeResponse {
+	return planResourceChangeResponse(ctx, fw, StateJSON)
+}
+
+// planResourceChangeResponse returns the *tfprotov6.PlanResourceChangeResponse
+// equivalent of a *fwserver.PlanResourceChangeResponse, encoding state values
+// with the given function.
+func planResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceChangeResponse, toState stateFunc) *tfprotov6.PlanResourceChangeResponse {
 	if fw == nil {
 		return nil
 	}
@@ -23,7 +37,7 @@ func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceCh
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
 	}
 
-	plannedState, diags := State(ctx, fw.PlannedState)
+	plannedState, diags := toState(ctx, fw.PlannedState)
 
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.PlannedState = plannedState
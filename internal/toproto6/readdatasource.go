@@ -13,6 +13,20 @@ import (
 // ReadDataSourceResponse returns the *tfprotov6.ReadDataSourceResponse
 // equivalent of a *fwserver.ReadDataSourceResponse.
 func ReadDataSourceResponse(ctx context.Context, fw *fwserver.ReadDataSourceResponse) *tfprotov6.ReadDataSourceResponse {
+	return readDataSourceResponse(ctx, fw, State)
+}
+
+// ReadDataSourceResponseJSON returns the *tfprotov6.ReadDataSourceResponse
+// equivalent of a *fwserver.ReadDataSourceResponse, with JSON encoded state
+// values when possible. This is used when request values are JSON encoded.
+func ReadDataSourceResponseJSON(ctx context.Context, fw *fwserver.ReadDataSourceResponse) *tfprotov6.ReadDataSourceResponse {
+	return readDataSourceResponse(ctx, fw, StateJSON)
+}
+
+// readDataSourceResponse returns the *tfprotov6.ReadDataSourceResponse
+// equivalent of a *fwserver.ReadDataSourceResponse, encoding state values
+// with the given function.
+func readDataSourceResponse(ctx context.Context, fw *fwserver.ReadDataSourceResponse, toState stateFunc) *tfprotov6.ReadDataSourceResponse {
 	if fw == nil {
 		return nil
 	}
@@ -21,7 +35,7 @@ func ReadDataSourceResponse(ctx context.Context, fw *fwserver.ReadDataSourceResp
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
 	}
 
-	state, diags := State(ctx, fw.State)
+	state, diags := toState(ctx, fw.State)
 
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.State = state
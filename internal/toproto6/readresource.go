@@ -14,6 +14,20 @@ import (
 // ReadResourceResponse returns the *tfprotov6.ReadResourceResponse
 // equivalent of a *fwserver.ReadResourceResponse.
 func ReadResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse) *tfprotov6.ReadResourceResponse {
+	return readResourceResponse(ctx, fw, State)
+}
+
+// ReadResourceResponseJSON returns the *tfprotov6.ReadResourceResponse
+// equivalent of a *fwserver.ReadResourceResponse, with JSON encoded state
+// values when possible. This is used when request values are JSON encoded.
+func ReadResourceResponseJSON(ctx context.Context, fw *fwserver.ReadResourceResponse) *tfprotov6.ReadResourceResponse {
+	return readResourceResponse(ctx, fw, StateJSON)
+}
+
+// readResourceResponse returns the *tfprotov6.ReadResourceResponse
+// equivalent of a *fwserver.ReadResourceResponse, encoding state values
+// with the given function.
+func readResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse, toState stateFunc) *tfprotov6.ReadResourceResponse {
 	if fw == nil {
 		return nil
 	}
@@ -22,7 +36,7 @@ func ReadResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
 	}
 
-	newState, diags := State(ctx, fw.NewState)
+	newState, diags := toState(ctx, fw.NewState)
 
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.NewState = newState
@@ -183,3 +183,81 @@ func TestReadResourceResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestReadResourceResponseJSON(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_attribute": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testProto6Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProto6UnknownValue := tftypes.NewValue(testProto6Type, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	testProto6UnknownDynamicValue, err := tfprotov6.NewDynamicValue(testProto6Type, testProto6UnknownValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+	}
+
+	testCases := map[string]struct {
+		input    *fwserver.ReadResourceResponse
+		expected *tfprotov6.ReadResourceResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"newstate": {
+			input: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testProto6Type, map[string]tftypes.Value{
+						"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+			expected: &tfprotov6.ReadResourceResponse{
+				NewState: &tfprotov6.DynamicValue{
+					JSON: []byte(`{"test_attribute":"test-value"}`),
+				},
+			},
+		},
+		"newstate-unknown": {
+			input: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testProto6UnknownValue,
+					Schema: testSchema,
+				},
+			},
+			expected: &tfprotov6.ReadResourceResponse{
+				NewState: &testProto6UnknownDynamicValue,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto6.ReadResourceResponseJSON(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
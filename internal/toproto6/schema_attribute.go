@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -26,26 +27,31 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		Optional:  a.IsOptional(),
 		Computed:  a.IsComputed(),
 		Sensitive: a.IsSensitive(),
-		Type:      a.GetType().TerraformType(ctx),
 	}
 
 	if a.GetDeprecationMessage() != "" {
 		schemaAttribute.Deprecated = true
 	}
 
-	if a.GetDescription() != "" {
-		schemaAttribute.Description = a.GetDescription()
+	description, descriptionKind := schema.EffectiveDescription(a)
+
+	if description != "" {
+		schemaAttribute.Description = description
 		schemaAttribute.DescriptionKind = tfprotov6.StringKindPlain
 	}
 
-	if a.GetMarkdownDescription() != "" {
-		schemaAttribute.Description = a.GetMarkdownDescription()
+	if descriptionKind == schema.DescriptionKindMarkdown {
 		schemaAttribute.DescriptionKind = tfprotov6.StringKindMarkdown
 	}
 
 	nestedAttribute, ok := a.(fwschema.NestedAttribute)
 
+	// Only convert the type for non-nested attributes, since nested
+	// attributes use NestedType instead and their object type conversion is
+	// comparatively expensive.
 	if !ok {
+		schemaAttribute.Type = a.GetType().TerraformType(ctx)
+
 		return schemaAttribute, nil
 	}
 
@@ -64,7 +70,13 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
 	}
 
-	for nestedName, nestedA := range nestedAttribute.GetNestedObject().GetAttributes() {
+	nestedAttributes := nestedAttribute.GetNestedObject().GetAttributes()
+
+	if len(nestedAttributes) > 0 {
+		object.Attributes = make([]*tfprotov6.SchemaAttribute, 0, len(nestedAttributes))
+	}
+
+	for nestedName, nestedA := range nestedAttributes {
 		nestedSchemaAttribute, err := SchemaAttribute(ctx, nestedName, path.WithAttributeName(nestedName), nestedA)
 
 		if err != nil {
@@ -87,7 +99,6 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 	})
 
 	schemaAttribute.NestedType = object
-	schemaAttribute.Type = nil
 
 	return schemaAttribute, nil
 }
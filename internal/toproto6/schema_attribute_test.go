@@ -262,6 +262,19 @@ func TestSchemaAttribute(t *testing.T) {
 				Sensitive: true,
 			},
 		},
+		"log-sensitive": {
+			name: "string",
+			attr: testschema.AttributeWithStringValidators{
+				Optional:     true,
+				LogSensitive: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:     "string",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+		},
 		"nested-attr-single": {
 			name: "single_nested",
 			attr: testschema.NestedAttribute{
@@ -303,6 +316,56 @@ func TestSchemaAttribute(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-single-descriptions": {
+			name: "single_nested",
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"description": testschema.Attribute{
+							Type:        types.StringType,
+							Optional:    true,
+							Description: "A string attribute",
+						},
+						"description_both": testschema.Attribute{
+							Type:                types.StringType,
+							Optional:            true,
+							Description:         "A string attribute",
+							MarkdownDescription: "A string attribute (markdown)",
+						},
+					},
+				},
+				NestingMode:         fwschema.NestingModeSingle,
+				Optional:            true,
+				Description:         "A nested attribute",
+				MarkdownDescription: "A nested attribute (markdown)",
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "single_nested",
+				Optional:        true,
+				Description:     "A nested attribute (markdown)",
+				DescriptionKind: tfprotov6.StringKindMarkdown,
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:            "description",
+							Optional:        true,
+							Type:            tftypes.String,
+							Description:     "A string attribute",
+							DescriptionKind: tfprotov6.StringKindPlain,
+						},
+						{
+							Name:            "description_both",
+							Optional:        true,
+							Type:            tftypes.String,
+							Description:     "A string attribute (markdown)",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+						},
+					},
+				},
+			},
+		},
 		"nested-attr-list": {
 			name: "list_nested",
 			attr: testschema.NestedAttribute{
@@ -5,6 +5,8 @@ package toproto6_test
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -655,3 +657,94 @@ func TestSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_deterministic(t *testing.T) {
+	t.Parallel()
+
+	attributes := make(map[string]fwschema.Attribute)
+	blocks := make(map[string]fwschema.Block)
+	nestedAttributes := make(map[string]fwschema.Attribute)
+
+	for i := 0; i < 20; i++ {
+		attributes[fmt.Sprintf("attribute_%02d", 19-i)] = testschema.Attribute{
+			Type:     types.StringType,
+			Optional: true,
+		}
+		nestedAttributes[fmt.Sprintf("nested_%02d", 19-i)] = testschema.Attribute{
+			Type:     types.StringType,
+			Optional: true,
+		}
+	}
+
+	attributes["nested_attribute"] = testschema.NestedAttribute{
+		NestedObject: testschema.NestedAttributeObject{
+			Attributes: nestedAttributes,
+		},
+		NestingMode: fwschema.NestingModeList,
+		Optional:    true,
+	}
+
+	for i := 0; i < 5; i++ {
+		blocks[fmt.Sprintf("block_%02d", 4-i)] = testschema.Block{
+			NestedObject: testschema.NestedBlockObject{
+				Attributes: nestedAttributes,
+			},
+			NestingMode: fwschema.BlockNestingModeList,
+		}
+	}
+
+	schema := testschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	expected, err := toproto6.Schema(context.Background(), schema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !sort.SliceIsSorted(expected.Block.Attributes, func(i, j int) bool {
+		return expected.Block.Attributes[i].Name < expected.Block.Attributes[j].Name
+	}) {
+		t.Errorf("expected sorted attributes")
+	}
+
+	if !sort.SliceIsSorted(expected.Block.BlockTypes, func(i, j int) bool {
+		return expected.Block.BlockTypes[i].TypeName < expected.Block.BlockTypes[j].TypeName
+	}) {
+		t.Errorf("expected sorted blocks")
+	}
+
+	for i := 0; i < 10; i++ {
+		got, err := toproto6.Schema(context.Background(), schema)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for _, attribute := range got.Block.Attributes {
+			if attribute.NestedType == nil {
+				continue
+			}
+
+			if !sort.SliceIsSorted(attribute.NestedType.Attributes, func(i, j int) bool {
+				return attribute.NestedType.Attributes[i].Name < attribute.NestedType.Attributes[j].Name
+			}) {
+				t.Errorf("expected sorted nested attributes in %s", attribute.Name)
+			}
+		}
+
+		for _, block := range got.Block.BlockTypes {
+			if !sort.SliceIsSorted(block.Block.Attributes, func(i, j int) bool {
+				return block.Block.Attributes[i].Name < block.Block.Attributes[j].Name
+			}) {
+				t.Errorf("expected sorted nested block attributes in %s", block.TypeName)
+			}
+		}
+
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Fatalf("unexpected difference between conversions: %s", diff)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse returns the Path represented by the given string, which uses the same
+// syntax as the Path type String method. For example:
+//
+//   - Attribute names separated by periods: disk.size
+//   - List element indices in brackets: disk[0].size
+//   - Map element keys as quoted strings in brackets: tags["example"]
+//
+// Set element values, such as [Value("example")], cannot be parsed since the
+// value type is unknown without a schema. An empty string returns an empty
+// Path. An error, including the position of the offending character, is
+// returned for malformed input.
+func Parse(s string) (Path, error) {
+	result := Empty()
+
+	if s == "" {
+		return result, nil
+	}
+
+	pos := 0
+
+	name, err := parseAttributeName(s, pos)
+
+	if err != nil {
+		return Empty(), err
+	}
+
+	result = result.AtName(name)
+	pos += len(name)
+
+	for pos < len(s) {
+		switch s[pos] {
+		case '.':
+			pos++
+
+			name, err := parseAttributeName(s, pos)
+
+			if err != nil {
+				return Empty(), err
+			}
+
+			result = result.AtName(name)
+			pos += len(name)
+		case '[':
+			pos++
+
+			if pos < len(s) && s[pos] == '"' {
+				key, length, err := parseElementKeyString(s, pos)
+
+				if err != nil {
+					return Empty(), err
+				}
+
+				result = result.AtMapKey(key)
+				pos += length
+			} else {
+				index, length, err := parseElementKeyInt(s, pos)
+
+				if err != nil {
+					return Empty(), err
+				}
+
+				result = result.AtListIndex(index)
+				pos += length
+			}
+
+			if pos >= len(s) || s[pos] != ']' {
+				return Empty(), parseError(s, pos, "expected ]")
+			}
+
+			pos++
+		default:
+			return Empty(), parseError(s, pos, "expected . or [")
+		}
+	}
+
+	return result, nil
+}
+
+// parseAttributeName returns the attribute name starting at the position,
+// which ends before the next period, bracket, or the end of the string.
+func parseAttributeName(s string, pos int) (string, error) {
+	length := strings.IndexAny(s[pos:], ".[]\"")
+
+	if length == -1 {
+		length = len(s) - pos
+	}
+
+	if length == 0 {
+		return "", parseError(s, pos, "expected attribute name")
+	}
+
+	return s[pos : pos+length], nil
+}
+
+// parseElementKeyInt returns the list index starting at the position and the
+// number of characters it uses.
+func parseElementKeyInt(s string, pos int) (int, int, error) {
+	length := 0
+
+	for pos+length < len(s) && s[pos+length] >= '0' && s[pos+length] <= '9' {
+		length++
+	}
+
+	if length == 0 {
+		if strings.HasPrefix(s[pos:], "Value(") {
+			return 0, 0, parseError(s, pos, "set element values are not supported")
+		}
+
+		return 0, 0, parseError(s, pos, "expected list index or quoted map key")
+	}
+
+	index, err := strconv.Atoi(s[pos : pos+length])
+
+	if err != nil {
+		return 0, 0, parseError(s, pos, fmt.Sprintf("invalid list index: %s", err))
+	}
+
+	return index, length, nil
+}
+
+// parseElementKeyString returns the unquoted map key starting at the position
+// of its opening quote and the number of characters it uses, including the
+// quotes.
+func parseElementKeyString(s string, pos int) (string, int, error) {
+	for end := pos + 1; end < len(s); end++ {
+		switch s[end] {
+		case '\\':
+			// skip escaped characters, such as quotes
+			end++
+		case '"':
+			key, err := strconv.Unquote(s[pos : end+1])
+
+			if err != nil {
+				return "", 0, parseError(s, pos, fmt.Sprintf("invalid map key: %s", err))
+			}
+
+			return key, end + 1 - pos, nil
+		}
+	}
+
+	return "", 0, parseError(s, pos, "unterminated map key")
+}
+
+// parseError returns an error for malformed input at the position.
+func parseError(s string, pos int, message string) error {
+	return fmt.Errorf("unable to parse path %q at position %d: %s", s, pos, message)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      path.Path
+		expectedError error
+	}{
+		"empty": {
+			input:    "",
+			expected: path.Empty(),
+		},
+		"attribute-name": {
+			input:    "test",
+			expected: path.Root("test"),
+		},
+		"attribute-name-nested": {
+			input:    "test.nested",
+			expected: path.Root("test").AtName("nested"),
+		},
+		"list-index": {
+			input:    "disk[0].size",
+			expected: path.Root("disk").AtListIndex(0).AtName("size"),
+		},
+		"list-index-nested": {
+			input:    "test[12][3]",
+			expected: path.Root("test").AtListIndex(12).AtListIndex(3),
+		},
+		"map-key": {
+			input:    `tags["example"]`,
+			expected: path.Root("tags").AtMapKey("example"),
+		},
+		"map-key-special-characters": {
+			input:    `tags["a.b[0]\"c"].nested`,
+			expected: path.Root("tags").AtMapKey(`a.b[0]"c`).AtName("nested"),
+		},
+		"leading-period": {
+			input:         ".test",
+			expectedError: errors.New(`unable to parse path ".test" at position 0: expected attribute name`),
+		},
+		"trailing-period": {
+			input:         "test.",
+			expectedError: errors.New(`unable to parse path "test." at position 5: expected attribute name`),
+		},
+		"leading-bracket": {
+			input:         "[0]",
+			expectedError: errors.New(`unable to parse path "[0]" at position 0: expected attribute name`),
+		},
+		"list-index-empty": {
+			input:         "test[]",
+			expectedError: errors.New(`unable to parse path "test[]" at position 5: expected list index or quoted map key`),
+		},
+		"list-index-negative": {
+			input:         "test[-1]",
+			expectedError: errors.New(`unable to parse path "test[-1]" at position 5: expected list index or quoted map key`),
+		},
+		"list-index-unterminated": {
+			input:         "test[0",
+			expectedError: errors.New(`unable to parse path "test[0" at position 6: expected ]`),
+		},
+		"map-key-unterminated": {
+			input:         `test["key]`,
+			expectedError: errors.New(`unable to parse path "test[\"key]" at position 5: unterminated map key`),
+		},
+		"set-value": {
+			input:         `test[Value("example")]`,
+			expectedError: errors.New(`unable to parse path "test[Value(\"example\")]" at position 5: set element values are not supported`),
+		},
+		"unexpected-character": {
+			input:         "test[0]size",
+			expectedError: errors.New(`unable to parse path "test[0]size" at position 7: expected . or [`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.Parse(testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParse_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]path.Path{
+		"empty":              path.Empty(),
+		"attribute-name":     path.Root("test"),
+		"nested":             path.Root("disk").AtListIndex(0).AtName("size"),
+		"map-key":            path.Root("tags").AtMapKey("example\n\"quoted\"").AtName("nested"),
+		"nested-collections": path.Root("test").AtMapKey("key").AtListIndex(1).AtMapKey("").AtName("a").AtName("b"),
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.Parse(testCase.String())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase) {
+				t.Errorf("expected %s, got %s", testCase, got)
+			}
+		})
+	}
+
+	// Set element values are not reversible without type information.
+	setValuePath := path.Root("test").AtSetValue(types.StringValue("example"))
+
+	if _, err := path.Parse(setValuePath.String()); err == nil {
+		t.Errorf("expected error parsing %s", setValuePath)
+	}
+}
@@ -23,6 +23,13 @@ type ConfigureRequest struct {
 	// information should usually be persisted to the underlying type
 	// that's implementing the Provider interface, for use in later
 	// resource CRUD operations.
+	//
+	// Configuration values may be unknown during planning, such as when
+	// they reference attributes of resources which are not yet created.
+	// Use the IsUnknown method of the value or the Config type PathIsUnknown
+	// method to detect this and skip creating clients which require the
+	// value. Resources and data sources will then receive nil ProviderData
+	// in their Configure method.
 	Config tfsdk.Config
 }
 
@@ -127,6 +127,15 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	return diags
 }
 
+// WalkAttributes calls the function for every attribute in the schema,
+// including nested attributes, with the path expression matching the
+// attribute values. Collection elements are represented with expression steps
+// matching any element, such as AtAnyListIndex. The order is deterministic,
+// which is useful for documentation generators and linters.
+func (s Schema) WalkAttributes(ctx context.Context, fn func(path.Expression, fwschema.Attribute)) {
+	fwschema.SchemaWalkAttributes(ctx, s, fn)
+}
+
 // schemaAttributes is a provider to fwschema type conversion function.
 func schemaAttributes(attributes map[string]Attribute) map[string]fwschema.Attribute {
 	result := make(map[string]fwschema.Attribute, len(attributes))
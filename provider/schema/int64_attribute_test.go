@@ -416,3 +416,37 @@ func TestInt64AttributeIsSensitive(t *testing.T) {
 		})
 	}
 }
+
+func TestInt64AttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Int64Attribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.Int64Attribute{},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.Int64Attribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                    = ListAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = ListAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ListAttribute{}
 	_ fwxschema.AttributeWithListValidators        = ListAttribute{}
 )
@@ -67,6 +68,10 @@ type ListAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -190,6 +195,11 @@ func (a ListAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a ListAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ListValidators returns the Validators field value.
 func (a ListAttribute) ListValidators() []validator.List {
 	return a.Validators
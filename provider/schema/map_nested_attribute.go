@@ -19,6 +19,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                      = MapNestedAttribute{}
+	_ fwschema.AttributeWithLogSensitive   = MapNestedAttribute{}
 	_ fwxschema.AttributeWithMapValidators = MapNestedAttribute{}
 )
 
@@ -76,6 +77,10 @@ type MapNestedAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -217,6 +222,11 @@ func (a MapNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a MapNestedAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // MapValidators returns the Validators field value.
 func (a MapNestedAttribute) MapValidators() []validator.Map {
 	return a.Validators
@@ -384,6 +384,40 @@ func TestNumberAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestNumberAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.NumberAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.NumberAttribute{},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.NumberAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNumberAttributeNumberValidators(t *testing.T) {
 	t.Parallel()
 
@@ -398,6 +398,40 @@ func TestObjectAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestObjectAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ObjectAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.ObjectAttribute{AttributeTypes: map[string]attr.Type{"testattr": types.StringType}},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.ObjectAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestObjectAttributeObjectValidators(t *testing.T) {
 	t.Parallel()
 
@@ -13,10 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -1085,6 +1090,89 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": datasourceschema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has the Computed field set in a provider schema. "+
+						"Provider schema attributes should be Required or Optional.",
+				),
+			},
+		},
+		"nested-attribute-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test": datasourceschema.StringAttribute{
+								Optional: true,
+								Computed: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test\" has the Computed field set in a provider schema. "+
+						"Provider schema attributes should be Required or Optional.",
+				),
+			},
+		},
+		"attribute-resource-plan-modifiers": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": resourceschema.StringAttribute{
+						Optional: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has the PlanModifiers field set in a provider schema. "+
+						"This field is only supported in resource schemas and is ignored.",
+				),
+			},
+		},
+		"block-resource-plan-modifiers": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": resourceschema.ListNestedBlock{
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Block Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has the PlanModifiers field set in a provider schema. "+
+						"This field is only supported in resource schemas and is ignored.",
+				),
+			},
+		},
 		"nested-attribute-using-nested-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -19,6 +19,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                      = SetNestedAttribute{}
+	_ fwschema.AttributeWithLogSensitive   = SetNestedAttribute{}
 	_ fwxschema.AttributeWithSetValidators = SetNestedAttribute{}
 )
 
@@ -71,6 +72,10 @@ type SetNestedAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -119,6 +124,16 @@ type SetNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// MinItems is the minimum number of elements that must be configured.
+	// Zero, the default, means there is no minimum. Null and unknown values
+	// are not checked.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements that can be configured.
+	// Zero, the default, means there is no maximum. Null and unknown values
+	// are not checked.
+	MaxItems int64
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -171,6 +186,16 @@ func (a SetNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMaxItems returns the MaxItems field value.
+func (a SetNestedAttribute) GetMaxItems() int64 {
+	return a.MaxItems
+}
+
+// GetMinItems returns the MinItems field value.
+func (a SetNestedAttribute) GetMinItems() int64 {
+	return a.MinItems
+}
+
 // GetNestedObject returns the NestedObject field value.
 func (a SetNestedAttribute) GetNestedObject() fwschema.NestedAttributeObject {
 	return a.NestedObject
@@ -212,6 +237,11 @@ func (a SetNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a SetNestedAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // SetValidators returns the Validators field value.
 func (a SetNestedAttribute) SetValidators() []validator.Set {
 	return a.Validators
@@ -19,6 +19,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                         = SingleNestedAttribute{}
+	_ fwschema.AttributeWithLogSensitive      = SingleNestedAttribute{}
 	_ fwxschema.AttributeWithObjectValidators = SingleNestedAttribute{}
 )
 
@@ -70,6 +71,10 @@ type SingleNestedAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -233,6 +238,11 @@ func (a SingleNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a SingleNestedAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ObjectValidators returns the Validators field value.
 func (a SingleNestedAttribute) ObjectValidators() []validator.Object {
 	return a.Validators
@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                               = StringAttribute{}
+	_ fwschema.AttributeWithLogSensitive      = StringAttribute{}
 	_ fwxschema.AttributeWithStringValidators = StringAttribute{}
 )
 
@@ -53,6 +54,10 @@ type StringAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -174,6 +179,11 @@ func (a StringAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a StringAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // StringValidators returns the Validators field value.
 func (a StringAttribute) StringValidators() []validator.String {
 	return a.Validators
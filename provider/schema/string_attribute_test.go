@@ -384,6 +384,40 @@ func TestStringAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestStringAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.StringAttribute{},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.StringAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestStringAttributeStringValidators(t *testing.T) {
 	t.Parallel()
 
@@ -23,11 +23,7 @@ import (
 // function and various terraform-plugin-mux functions.
 func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
 	return func() tfprotov5.ProviderServer {
-		return &proto5server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}
+		return newProtocol5Server(p, false)
 	}
 }
 
@@ -38,11 +34,7 @@ func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
 // The error return is not currently used, but it may be in the future.
 func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer, error) {
 	return func() (tfprotov5.ProviderServer, error) {
-		return &proto5server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}, nil
+		return newProtocol5Server(p, false), nil
 	}
 }
 
@@ -52,11 +44,7 @@ func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer
 // function and various terraform-plugin-mux functions.
 func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
 	return func() tfprotov6.ProviderServer {
-		return &proto6server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}
+		return newProtocol6Server(p, false)
 	}
 }
 
@@ -67,11 +55,7 @@ func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
 // The error return is not currently used, but it may be in the future.
 func NewProtocol6WithError(p provider.Provider) func() (tfprotov6.ProviderServer, error) {
 	return func() (tfprotov6.ProviderServer, error) {
-		return &proto6server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}, nil
+		return newProtocol6Server(p, false), nil
 	}
 }
 
@@ -94,13 +78,7 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 		return tf5server.Serve(
 			opts.Address,
 			func() tfprotov5.ProviderServer {
-				provider := providerFunc()
-
-				return &proto5server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				return newProtocol5Server(providerFunc(), opts.ValidateDescriptions)
 			},
 			tf5serverOpts...,
 		)
@@ -114,15 +92,45 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 		return tf6server.Serve(
 			opts.Address,
 			func() tfprotov6.ProviderServer {
-				provider := providerFunc()
-
-				return &proto6server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				return newProtocol6Server(providerFunc(), opts.ValidateDescriptions)
 			},
 			tf6serverOpts...,
 		)
 	}
 }
+
+// newProtocol5Server returns a protocol version 5 server for the given
+// Provider. The GetProviderSchema response is built, validated, and cached
+// before the server is returned, so it is not recomputed on each call.
+func newProtocol5Server(p provider.Provider, validateDescriptions bool) *proto5server.Server {
+	server := &proto5server.Server{
+		FrameworkServer: fwserver.Server{
+			Provider:             p,
+			ValidateDescriptions: validateDescriptions,
+		},
+	}
+
+	// Any error diagnostics are not cached and are instead returned when
+	// Terraform calls the GetProviderSchema RPC.
+	_, _ = server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	return server
+}
+
+// newProtocol6Server returns a protocol version 6 server for the given
+// Provider. The GetProviderSchema response is built, validated, and cached
+// before the server is returned, so it is not recomputed on each call.
+func newProtocol6Server(p provider.Provider, validateDescriptions bool) *proto6server.Server {
+	server := &proto6server.Server{
+		FrameworkServer: fwserver.Server{
+			Provider:             p,
+			ValidateDescriptions: validateDescriptions,
+		},
+	}
+
+	// Any error diagnostics are not cached and are instead returned when
+	// Terraform calls the GetProviderSchema RPC.
+	_, _ = server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	return server
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -81,3 +82,59 @@ func TestNewProtocol6WithError(t *testing.T) {
 		t.Fatalf("unexpected error calling ProviderServer: %s", err)
 	}
 }
+
+func TestNewProtocol5_getProviderSchemaCached(t *testing.T) {
+	t.Parallel()
+
+	var schemaCalls int
+
+	testProvider := &testprovider.Provider{
+		SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+			schemaCalls++
+		},
+	}
+
+	providerServer := NewProtocol5(testProvider)()
+
+	if schemaCalls != 1 {
+		t.Fatalf("expected provider Schema to be called once on creation, got %d calls", schemaCalls)
+	}
+
+	_, err := providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if schemaCalls != 1 {
+		t.Errorf("expected provider Schema to be called once, got %d calls", schemaCalls)
+	}
+}
+
+func TestNewProtocol6_getProviderSchemaCached(t *testing.T) {
+	t.Parallel()
+
+	var schemaCalls int
+
+	testProvider := &testprovider.Provider{
+		SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+			schemaCalls++
+		},
+	}
+
+	providerServer := NewProtocol6(testProvider)()
+
+	if schemaCalls != 1 {
+		t.Fatalf("expected provider Schema to be called once on creation, got %d calls", schemaCalls)
+	}
+
+	_, err := providerServer.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if schemaCalls != 1 {
+		t.Errorf("expected provider Schema to be called once, got %d calls", schemaCalls)
+	}
+}
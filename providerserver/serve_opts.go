@@ -34,6 +34,19 @@ type ServeOpts struct {
 	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
 	//
 	ProtocolVersion int
+
+	// ValidateDescriptions enables warning logs during the GetProviderSchema
+	// RPC for every attribute and block, including nested ones, which has
+	// neither Description nor MarkdownDescription set. Missing descriptions
+	// are valid, so this is intended for provider developers checking
+	// documentation completeness, such as with a command line flag when
+	// generating documentation. Defaults to false.
+	//
+	// This option is only honored by Serve. Provider servers created with
+	// NewProtocol5, NewProtocol6, or their WithError variants, such as when
+	// using terraform-plugin-mux or terraform-plugin-testing, never log these
+	// warnings.
+	ValidateDescriptions bool
 }
 
 // Validate a given provider address. This is only used for the Address field
@@ -5,6 +5,8 @@ package resource
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -60,3 +62,52 @@ func ImportStatePassthroughID(ctx context.Context, attrPath path.Path, req Impor
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
 }
+
+// ImportStatePassthroughIDParts is a helper function to split the import
+// identifier by the separator and set each part to the state attribute path
+// at the same position. Each attribute must accept a string value. An error
+// diagnostic is returned if the number of parts does not match the number of
+// attribute paths or if any part is empty.
+func ImportStatePassthroughIDParts(ctx context.Context, separator string, attrPaths path.Paths, req ImportStateRequest, resp *ImportStateResponse) {
+	if len(attrPaths) == 0 || separator == "" {
+		resp.Diagnostics.AddError(
+			"Resource Import Passthrough Missing Attribute Paths",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStatePassthroughIDParts must have a non-empty separator and at least one attribute path that can accept a string value.",
+		)
+
+		return
+	}
+
+	idParts := strings.Split(req.ID, separator)
+
+	if len(idParts) != len(attrPaths) || containsEmptyString(idParts) {
+		expectedParts := make([]string, 0, len(attrPaths))
+
+		for _, attrPath := range attrPaths {
+			expectedParts = append(expectedParts, attrPath.String())
+		}
+
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(expectedParts, separator), req.ID),
+		)
+
+		return
+	}
+
+	for idx, attrPath := range attrPaths {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, idParts[idx])...)
+	}
+}
+
+// containsEmptyString returns true if any of the strings are empty.
+func containsEmptyString(values []string) bool {
+	for _, value := range values {
+		if value == "" {
+			return true
+		}
+	}
+
+	return false
+}
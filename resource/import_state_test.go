@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestImportStatePassthroughIDParts(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"project": schema.StringAttribute{
+				Required: true,
+			},
+			"region": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":    tftypes.String,
+			"project": tftypes.String,
+			"region":  tftypes.String,
+		},
+	}
+
+	testAttrPaths := path.Paths{
+		path.Root("project"),
+		path.Root("region"),
+		path.Root("name"),
+	}
+
+	testCases := map[string]struct {
+		separator     string
+		attrPaths     path.Paths
+		id            string
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			separator: "/",
+			attrPaths: testAttrPaths,
+			id:        "test-project/test-region/test-name",
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				"project": tftypes.NewValue(tftypes.String, "test-project"),
+				"region":  tftypes.NewValue(tftypes.String, "test-region"),
+			}),
+		},
+		"too-few-parts": {
+			separator: "/",
+			attrPaths: testAttrPaths,
+			id:        "test-project/test-name",
+			expected:  tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: project/region/name. Got: "test-project/test-name"`,
+				),
+			},
+		},
+		"too-many-parts": {
+			separator: "/",
+			attrPaths: testAttrPaths,
+			id:        "test-project/test-region/test-name/test-extra",
+			expected:  tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: project/region/name. Got: "test-project/test-region/test-name/test-extra"`,
+				),
+			},
+		},
+		"empty-part": {
+			separator: "/",
+			attrPaths: testAttrPaths,
+			id:        "test-project//test-name",
+			expected:  tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: project/region/name. Got: "test-project//test-name"`,
+				),
+			},
+		},
+		"missing-attribute-paths": {
+			separator: "/",
+			id:        "test-project/test-region/test-name",
+			expected:  tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Import Passthrough Missing Attribute Paths",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource ImportState method call to ImportStatePassthroughIDParts must have a non-empty separator and at least one attribute path that can accept a string value.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ImportStateRequest{
+				ID: testCase.id,
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+			}
+
+			resource.ImportStatePassthroughIDParts(context.Background(), testCase.separator, testCase.attrPaths, req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
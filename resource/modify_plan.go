@@ -31,6 +31,14 @@ type ModifyPlanRequest struct {
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
 
+	// RequiresReplace is the sorted list of attribute paths which were
+	// already marked as requiring resource replacement by attribute plan
+	// modifiers. It is informational, such as for returning diagnostics
+	// about an upcoming replacement. These paths are always included in the
+	// response to Terraform, so append any additional paths to
+	// ModifyPlanResponse.RequiresReplace rather than copying these.
+	RequiresReplace path.Paths
+
 	// Private is provider-defined resource private state data which was previously
 	// stored with the resource state. This data is opaque to Terraform and does
 	// not affect plan output. Any existing data is copied to
@@ -53,6 +61,11 @@ type ModifyPlanResponse struct {
 	// resource to be replaced. They should point to the specific field
 	// that changed that requires the resource to be destroyed and
 	// recreated.
+	//
+	// Terraform only replaces the resource if the planned value at one of
+	// these paths differs from the prior state value. Appending the root
+	// path, path.Empty(), marks the whole resource for replacement if any
+	// value changes, in addition to any attribute paths.
 	RequiresReplace path.Paths
 
 	// Private is the private state resource data following the ModifyPlan operation.
@@ -39,6 +39,9 @@ type ReadResponse struct {
 	// State is the state of the resource following the Read operation.
 	// This field is pre-populated from ReadRequest.State and
 	// should be set during the resource's Read operation.
+	//
+	// If the remote resource no longer exists, call State.RemoveResource()
+	// so Terraform removes the resource from state and plans to recreate it.
 	State tfsdk.State
 
 	// Private is the private state resource data following the Read operation.
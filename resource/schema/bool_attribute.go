@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = BoolAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = BoolAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = BoolAttribute{}
 	_ fwschema.AttributeWithBoolDefaultValue       = BoolAttribute{}
 	_ fwxschema.AttributeWithBoolPlanModifiers     = BoolAttribute{}
@@ -68,6 +69,10 @@ type BoolAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -229,11 +234,20 @@ func (a BoolAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a BoolAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a BoolAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.BoolDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
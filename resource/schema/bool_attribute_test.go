@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -512,6 +513,40 @@ func TestBoolAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.BoolAttribute{},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.BoolAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeValidateImplementation(t *testing.T) {
 	t.Parallel()
 
@@ -520,6 +555,42 @@ func TestBoolAttributeValidateImplementation(t *testing.T) {
 		request   fwschema.ValidateImplementationRequest
 		expected  *fwschema.ValidateImplementationResponse
 	}{
+		"computed-validators": {
+			attribute: schema.BoolAttribute{
+				Computed: true,
+				Validators: []validator.Bool{
+					testvalidator.Bool{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Schema Using Attribute Validators For Computed-Only Attribute",
+						"Attribute \"test\" should be optional when using validators, otherwise the validators are never called. "+
+							"Validators only check configuration values, which are always null for attributes that are computed and not optional. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"computed-optional-validators": {
+			attribute: schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Validators: []validator.Bool{
+					testvalidator.Bool{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
 		"computed": {
 			attribute: schema.BoolAttribute{
 				Computed: true,
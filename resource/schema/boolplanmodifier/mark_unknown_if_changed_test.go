@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarkUnknownIfChangedModifierPlanModifyBool(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"input": schema.StringAttribute{
+				Optional: true,
+			},
+			"testattr": schema.BoolAttribute{Computed: true},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"input":    tftypes.String,
+			"testattr": tftypes.Bool,
+		},
+	}
+
+	testTfValue := func(input string) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"input":    tftypes.NewValue(tftypes.String, input),
+			"testattr": tftypes.NewValue(tftypes.Bool, true),
+		})
+	}
+
+	testPlan := func(input string) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	testState := func(input string) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	// Plan with changes from earlier plan modifiers.
+	testChangedPlan := testPlan("new")
+
+	testCases := map[string]struct {
+		expressions  []path.Expression
+		request      planmodifier.BoolRequest
+		responsePlan *tfsdk.Plan // defaults to request Plan
+		expected     *planmodifier.BoolResponse
+	}{
+		"dependency-changed": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.BoolValue(true),
+				State:          testState("old"),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"dependency-changed-response-plan": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.BoolValue(true),
+				State:          testState("old"),
+				StateValue:     types.BoolValue(true),
+			},
+			responsePlan: &testChangedPlan,
+			expected: &planmodifier.BoolResponse{
+				Plan:      testChangedPlan,
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"dependency-unchanged": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.BoolValue(true),
+				State:          testState("old"),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				Plan:      testPlan("old"),
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"dependency-relative-expression": {
+			expressions: []path.Expression{path.MatchRelative().AtParent().AtName("input")},
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.BoolValue(true),
+				State:          testState("old"),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"dependency-invalid-expression": {
+			expressions: []path.Expression{path.MatchRoot("invalid")},
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.BoolValue(true),
+				State:          testState("old"),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				Plan: testPlan("new"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Path Expression for Schema",
+						"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+							"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path Expression: invalid",
+					),
+				},
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"configured": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolValue(true),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.BoolValue(true),
+				State:          testState("old"),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"resource-create": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.BoolValue(true),
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    tftypes.NewValue(testType, nil),
+				},
+				StateValue: types.BoolNull(),
+			},
+			expected: &planmodifier.BoolResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.BoolValue(true),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.BoolResponse{
+				Plan:      testCase.request.Plan,
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			if testCase.responsePlan != nil {
+				resp.Plan = *testCase.responsePlan
+			}
+
+			boolplanmodifier.MarkUnknownIfChanged(testCase.expressions...).PlanModifyBool(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = Float64Attribute{}
+	_ fwschema.AttributeWithLogSensitive           = Float64Attribute{}
 	_ fwschema.AttributeWithValidateImplementation = Float64Attribute{}
 	_ fwschema.AttributeWithFloat64DefaultValue    = Float64Attribute{}
 	_ fwxschema.AttributeWithFloat64PlanModifiers  = Float64Attribute{}
@@ -71,6 +72,10 @@ type Float64Attribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -232,11 +237,20 @@ func (a Float64Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a Float64Attribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a Float64Attribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.Float64DefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
@@ -511,6 +512,40 @@ func TestFloat64AttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.Float64Attribute{},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.Float64Attribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeValidateImplementation(t *testing.T) {
 	t.Parallel()
 
@@ -519,6 +554,42 @@ func TestFloat64AttributeValidateImplementation(t *testing.T) {
 		request   fwschema.ValidateImplementationRequest
 		expected  *fwschema.ValidateImplementationResponse
 	}{
+		"computed-validators": {
+			attribute: schema.Float64Attribute{
+				Computed: true,
+				Validators: []validator.Float64{
+					testvalidator.Float64{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Schema Using Attribute Validators For Computed-Only Attribute",
+						"Attribute \"test\" should be optional when using validators, otherwise the validators are never called. "+
+							"Validators only check configuration values, which are always null for attributes that are computed and not optional. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"computed-optional-validators": {
+			attribute: schema.Float64Attribute{
+				Computed: true,
+				Optional: true,
+				Validators: []validator.Float64{
+					testvalidator.Float64{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
 		"computed": {
 			attribute: schema.Float64Attribute{
 				Computed: true,
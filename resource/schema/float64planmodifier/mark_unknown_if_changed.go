@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MarkUnknownIfChanged returns a plan modifier that sets the planned value to
+// unknown if the planned value of any attribute matching the given path
+// expressions differs from its prior state value. Use this for Computed
+// attributes which are derived from other attributes, when another plan
+// modifier, such as UseStateForUnknown, would otherwise plan the prior state
+// value.
+//
+// Relative path expressions are resolved from the attribute with this plan
+// modifier. The planned value is not changed on resource creation or destroy,
+// or if the attribute is configured.
+func MarkUnknownIfChanged(expressions ...path.Expression) planmodifier.Float64 {
+	return markUnknownIfChangedModifier{
+		expressions: expressions,
+	}
+}
+
+// markUnknownIfChangedModifier implements the plan modifier.
+type markUnknownIfChangedModifier struct {
+	expressions path.Expressions
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m markUnknownIfChangedModifier) Description(_ context.Context) string {
+	return "If the value of any of these attributes changes, the value of this attribute will be known after apply: " + m.expressions.String()
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m markUnknownIfChangedModifier) MarkdownDescription(_ context.Context) string {
+	return "If the value of any of these attributes changes, the value of this attribute will be known after apply: " + m.expressions.String()
+}
+
+// PlanModifyFloat64 implements the plan modification logic.
+func (m markUnknownIfChangedModifier) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+	// Do nothing on resource creation.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a configuration value, which cannot be changed.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// Do nothing if the planned value is already unknown.
+	if resp.PlanValue.IsUnknown() {
+		return
+	}
+
+	changed, diags := fwplanmodifier.PathsChanged(ctx, req.PathExpression, m.expressions, resp.Plan, req.State)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !changed {
+		return
+	}
+
+	resp.PlanValue = types.Float64Unknown()
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarkUnknownIfChangedModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"input": schema.StringAttribute{
+				Optional: true,
+			},
+			"testattr": schema.Float64Attribute{Computed: true},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"input":    tftypes.String,
+			"testattr": tftypes.Number,
+		},
+	}
+
+	testTfValue := func(input string) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"input":    tftypes.NewValue(tftypes.String, input),
+			"testattr": tftypes.NewValue(tftypes.Number, 1.2),
+		})
+	}
+
+	testPlan := func(input string) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	testState := func(input string) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	// Plan with changes from earlier plan modifiers.
+	testChangedPlan := testPlan("new")
+
+	testCases := map[string]struct {
+		expressions  []path.Expression
+		request      planmodifier.Float64Request
+		responsePlan *tfsdk.Plan // defaults to request Plan
+		expected     *planmodifier.Float64Response
+	}{
+		"dependency-changed": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Float64Value(1.2),
+				State:          testState("old"),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"dependency-changed-response-plan": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.Float64Value(1.2),
+				State:          testState("old"),
+				StateValue:     types.Float64Value(1.2),
+			},
+			responsePlan: &testChangedPlan,
+			expected: &planmodifier.Float64Response{
+				Plan:      testChangedPlan,
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"dependency-unchanged": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.Float64Value(1.2),
+				State:          testState("old"),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				Plan:      testPlan("old"),
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"dependency-relative-expression": {
+			expressions: []path.Expression{path.MatchRelative().AtParent().AtName("input")},
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Float64Value(1.2),
+				State:          testState("old"),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"dependency-invalid-expression": {
+			expressions: []path.Expression{path.MatchRoot("invalid")},
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Float64Value(1.2),
+				State:          testState("old"),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				Plan: testPlan("new"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Path Expression for Schema",
+						"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+							"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path Expression: invalid",
+					),
+				},
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"configured": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Value(1.2),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Float64Value(1.2),
+				State:          testState("old"),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"resource-create": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Float64Value(1.2),
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    tftypes.NewValue(testType, nil),
+				},
+				StateValue: types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float64Response{
+				Plan:      testCase.request.Plan,
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			if testCase.responsePlan != nil {
+				resp.Plan = *testCase.responsePlan
+			}
+
+			float64planmodifier.MarkUnknownIfChanged(testCase.expressions...).PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
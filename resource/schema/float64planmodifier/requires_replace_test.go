@@ -151,3 +151,18 @@ func TestRequiresReplaceModifierPlanModifyFloat64(t *testing.T) {
 		})
 	}
 }
+
+func TestRequiresReplaceModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	modifier := float64planmodifier.RequiresReplace()
+	expected := "If the value of this attribute changes, Terraform will destroy and recreate the resource."
+
+	if got := modifier.Description(context.Background()); got != expected {
+		t.Errorf("expected description %q, got: %q", expected, got)
+	}
+
+	if got := modifier.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("expected markdown description %q, got: %q", expected, got)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarkUnknownIfChangedModifierPlanModifyInt64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"input": schema.StringAttribute{
+				Optional: true,
+			},
+			"testattr": schema.Int64Attribute{Computed: true},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"input":    tftypes.String,
+			"testattr": tftypes.Number,
+		},
+	}
+
+	testTfValue := func(input string) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"input":    tftypes.NewValue(tftypes.String, input),
+			"testattr": tftypes.NewValue(tftypes.Number, 12),
+		})
+	}
+
+	testPlan := func(input string) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	testState := func(input string) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	// Plan with changes from earlier plan modifiers.
+	testChangedPlan := testPlan("new")
+
+	testCases := map[string]struct {
+		expressions  []path.Expression
+		request      planmodifier.Int64Request
+		responsePlan *tfsdk.Plan // defaults to request Plan
+		expected     *planmodifier.Int64Response
+	}{
+		"dependency-changed": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Int64Value(12),
+				State:          testState("old"),
+				StateValue:     types.Int64Value(12),
+			},
+			expected: &planmodifier.Int64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+		"dependency-changed-response-plan": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.Int64Value(12),
+				State:          testState("old"),
+				StateValue:     types.Int64Value(12),
+			},
+			responsePlan: &testChangedPlan,
+			expected: &planmodifier.Int64Response{
+				Plan:      testChangedPlan,
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+		"dependency-unchanged": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.Int64Value(12),
+				State:          testState("old"),
+				StateValue:     types.Int64Value(12),
+			},
+			expected: &planmodifier.Int64Response{
+				Plan:      testPlan("old"),
+				PlanValue: types.Int64Value(12),
+			},
+		},
+		"dependency-relative-expression": {
+			expressions: []path.Expression{path.MatchRelative().AtParent().AtName("input")},
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Int64Value(12),
+				State:          testState("old"),
+				StateValue:     types.Int64Value(12),
+			},
+			expected: &planmodifier.Int64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+		"dependency-invalid-expression": {
+			expressions: []path.Expression{path.MatchRoot("invalid")},
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Int64Value(12),
+				State:          testState("old"),
+				StateValue:     types.Int64Value(12),
+			},
+			expected: &planmodifier.Int64Response{
+				Plan: testPlan("new"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Path Expression for Schema",
+						"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+							"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path Expression: invalid",
+					),
+				},
+				PlanValue: types.Int64Value(12),
+			},
+		},
+		"configured": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Value(12),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Int64Value(12),
+				State:          testState("old"),
+				StateValue:     types.Int64Value(12),
+			},
+			expected: &planmodifier.Int64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Int64Value(12),
+			},
+		},
+		"resource-create": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.Int64Value(12),
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    tftypes.NewValue(testType, nil),
+				},
+				StateValue: types.Int64Null(),
+			},
+			expected: &planmodifier.Int64Response{
+				Plan:      testPlan("new"),
+				PlanValue: types.Int64Value(12),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int64Response{
+				Plan:      testCase.request.Plan,
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			if testCase.responsePlan != nil {
+				resp.Plan = *testCase.responsePlan
+			}
+
+			int64planmodifier.MarkUnknownIfChanged(testCase.expressions...).PlanModifyInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -144,6 +144,36 @@ func TestRequiresReplaceIfModifierPlanModifyInt64(t *testing.T) {
 				RequiresReplace: true,
 			},
 		},
+		"planvalue-statevalue-increase": {
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(2)),
+				PlanValue:  types.Int64Value(2),
+				State:      testState(types.Int64Value(1)),
+				StateValue: types.Int64Value(1),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = req.PlanValue.ValueInt64() > req.StateValue.ValueInt64() // should reach here
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue:       types.Int64Value(2),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-statevalue-decrease": {
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(1)),
+				PlanValue:  types.Int64Value(1),
+				State:      testState(types.Int64Value(2)),
+				StateValue: types.Int64Value(2),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = req.PlanValue.ValueInt64() > req.StateValue.ValueInt64() // should reach here
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue:       types.Int64Value(1),
+				RequiresReplace: false,
+			},
+		},
 		"planvalue-statevalue-equal": {
 			request: planmodifier.Int64Request{
 				Plan:       testPlan(types.Int64Value(1)),
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = ListAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = ListAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ListAttribute{}
 	_ fwschema.AttributeWithListDefaultValue       = ListAttribute{}
 	_ fwxschema.AttributeWithListPlanModifiers     = ListAttribute{}
@@ -79,6 +80,10 @@ type ListAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -227,6 +232,11 @@ func (a ListAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a ListAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ListDefaultValue returns the Default field value.
 func (a ListAttribute) ListDefaultValue() defaults.List {
 	return a.Default
@@ -247,6 +257,10 @@ func (a ListAttribute) ListValidators() []validator.List {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a ListAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if a.CustomType == nil && a.ElementType == nil {
 		resp.Diagnostics.Append(fwschema.AttributeMissingElementTypeDiag(req.Path))
 	}
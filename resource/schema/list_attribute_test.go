@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
@@ -403,6 +404,40 @@ func TestListAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestListAttributeIsLogSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  bool
+	}{
+		"not-log-sensitive": {
+			attribute: schema.ListAttribute{ElementType: types.StringType},
+			expected:  false,
+		},
+		"log-sensitive": {
+			attribute: schema.ListAttribute{
+				LogSensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsLogSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeListDefaultValue(t *testing.T) {
 	t.Parallel()
 
@@ -540,6 +575,44 @@ func TestListAttributeValidateImplementation(t *testing.T) {
 		request   fwschema.ValidateImplementationRequest
 		expected  *fwschema.ValidateImplementationResponse
 	}{
+		"computed-validators": {
+			attribute: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Validators: []validator.List{
+					testvalidator.List{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Schema Using Attribute Validators For Computed-Only Attribute",
+						"Attribute \"test\" should be optional when using validators, otherwise the validators are never called. "+
+							"Validators only check configuration values, which are always null for attributes that are computed and not optional. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"computed-optional-validators": {
+			attribute: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.List{
+					testvalidator.List{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
 		"computed": {
 			attribute: schema.ListAttribute{
 				Computed:    true,
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarkUnknownIfChangedModifierPlanModifyList(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"input": schema.StringAttribute{
+				Optional: true,
+			},
+			"testattr": schema.ListAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"input":    tftypes.String,
+			"testattr": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testTfValue := func(input string) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"input":    tftypes.NewValue(tftypes.String, input),
+			"testattr": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "computed")}),
+		})
+	}
+
+	testPlan := func(input string) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	testState := func(input string) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	// Plan with changes from earlier plan modifiers.
+	testChangedPlan := testPlan("new")
+
+	testCases := map[string]struct {
+		expressions  []path.Expression
+		request      planmodifier.ListRequest
+		responsePlan *tfsdk.Plan // defaults to request Plan
+		expected     *planmodifier.ListResponse
+	}{
+		"dependency-changed": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				State:          testState("old"),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+			expected: &planmodifier.ListResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"dependency-changed-response-plan": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				State:          testState("old"),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+			responsePlan: &testChangedPlan,
+			expected: &planmodifier.ListResponse{
+				Plan:      testChangedPlan,
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"dependency-unchanged": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				State:          testState("old"),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+			expected: &planmodifier.ListResponse{
+				Plan:      testPlan("old"),
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+		},
+		"dependency-relative-expression": {
+			expressions: []path.Expression{path.MatchRelative().AtParent().AtName("input")},
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				State:          testState("old"),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+			expected: &planmodifier.ListResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"dependency-invalid-expression": {
+			expressions: []path.Expression{path.MatchRoot("invalid")},
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				State:          testState("old"),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+			expected: &planmodifier.ListResponse{
+				Plan: testPlan("new"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Path Expression for Schema",
+						"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+							"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path Expression: invalid",
+					),
+				},
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+		},
+		"configured": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				State:          testState("old"),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+			expected: &planmodifier.ListResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+		},
+		"resource-create": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    tftypes.NewValue(testType, nil),
+				},
+				StateValue: types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ListResponse{
+				Plan:      testCase.request.Plan,
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			if testCase.responsePlan != nil {
+				resp.Plan = *testCase.responsePlan
+			}
+
+			listplanmodifier.MarkUnknownIfChanged(testCase.expressions...).PlanModifyList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -171,3 +171,18 @@ func TestRequiresReplaceIfConfiguredModifierPlanModifyList(t *testing.T) {
 		})
 	}
 }
+
+func TestRequiresReplaceIfConfiguredModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	modifier := listplanmodifier.RequiresReplaceIfConfigured()
+	expected := "If the value of this attribute is configured and changes, Terraform will destroy and recreate the resource."
+
+	if got := modifier.Description(context.Background()); got != expected {
+		t.Errorf("expected description %q, got: %q", expected, got)
+	}
+
+	if got := modifier.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("expected markdown description %q, got: %q", expected, got)
+	}
+}
@@ -138,3 +138,18 @@ func TestUseStateForUnknownModifierPlanModifyList(t *testing.T) {
 		})
 	}
 }
+
+func TestUseStateForUnknownModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	modifier := listplanmodifier.UseStateForUnknown()
+	expected := "Once set, the value of this attribute in state will not change."
+
+	if got := modifier.Description(context.Background()); got != expected {
+		t.Errorf("expected description %q, got: %q", expected, got)
+	}
+
+	if got := modifier.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("expected markdown description %q, got: %q", expected, got)
+	}
+}
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = MapAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = MapAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = MapAttribute{}
 	_ fwschema.AttributeWithMapDefaultValue        = MapAttribute{}
 	_ fwxschema.AttributeWithMapPlanModifiers      = MapAttribute{}
@@ -82,6 +83,10 @@ type MapAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -230,6 +235,11 @@ func (a MapAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a MapAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // MapDefaultValue returns the Default field value.
 func (a MapAttribute) MapDefaultValue() defaults.Map {
 	return a.Default
@@ -250,6 +260,10 @@ func (a MapAttribute) MapValidators() []validator.Map {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a MapAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if a.CustomType == nil && a.ElementType == nil {
 		resp.Diagnostics.Append(fwschema.AttributeMissingElementTypeDiag(req.Path))
 	}
@@ -22,6 +22,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                              = MapNestedAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = MapNestedAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = MapNestedAttribute{}
 	_ fwschema.AttributeWithMapDefaultValue        = MapNestedAttribute{}
 	_ fwxschema.AttributeWithMapPlanModifiers      = MapNestedAttribute{}
@@ -89,6 +90,10 @@ type MapNestedAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -255,6 +260,11 @@ func (a MapNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a MapNestedAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // MapDefaultValue returns the Default field value.
 func (a MapNestedAttribute) MapDefaultValue() defaults.Map {
 	return a.Default
@@ -275,6 +285,10 @@ func (a MapNestedAttribute) MapValidators() []validator.Map {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a MapNestedAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if a.MapDefaultValue() != nil {
 		if !a.IsComputed() {
 			resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = NumberAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = NumberAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = NumberAttribute{}
 	_ fwschema.AttributeWithNumberDefaultValue     = NumberAttribute{}
 	_ fwxschema.AttributeWithNumberPlanModifiers   = NumberAttribute{}
@@ -72,6 +73,10 @@ type NumberAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -218,6 +223,11 @@ func (a NumberAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a NumberAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // NumberDefaultValue returns the Default field value.
 func (a NumberAttribute) NumberDefaultValue() defaults.Number {
 	return a.Default
@@ -238,6 +248,10 @@ func (a NumberAttribute) NumberValidators() []validator.Number {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a NumberAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.NumberDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
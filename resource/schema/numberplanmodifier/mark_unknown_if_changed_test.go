@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numberplanmodifier_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarkUnknownIfChangedModifierPlanModifyNumber(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"input": schema.StringAttribute{
+				Optional: true,
+			},
+			"testattr": schema.NumberAttribute{Computed: true},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"input":    tftypes.String,
+			"testattr": tftypes.Number,
+		},
+	}
+
+	testTfValue := func(input string) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"input":    tftypes.NewValue(tftypes.String, input),
+			"testattr": tftypes.NewValue(tftypes.Number, big.NewFloat(1.2)),
+		})
+	}
+
+	testPlan := func(input string) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	testState := func(input string) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testTfValue(input),
+		}
+	}
+
+	// Plan with changes from earlier plan modifiers.
+	testChangedPlan := testPlan("new")
+
+	testCases := map[string]struct {
+		expressions  []path.Expression
+		request      planmodifier.NumberRequest
+		responsePlan *tfsdk.Plan // defaults to request Plan
+		expected     *planmodifier.NumberResponse
+	}{
+		"dependency-changed": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.NumberValue(big.NewFloat(1.2)),
+				State:          testState("old"),
+				StateValue:     types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"dependency-changed-response-plan": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.NumberValue(big.NewFloat(1.2)),
+				State:          testState("old"),
+				StateValue:     types.NumberValue(big.NewFloat(1.2)),
+			},
+			responsePlan: &testChangedPlan,
+			expected: &planmodifier.NumberResponse{
+				Plan:      testChangedPlan,
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"dependency-unchanged": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("old"),
+				PlanValue:      types.NumberValue(big.NewFloat(1.2)),
+				State:          testState("old"),
+				StateValue:     types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				Plan:      testPlan("old"),
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"dependency-relative-expression": {
+			expressions: []path.Expression{path.MatchRelative().AtParent().AtName("input")},
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.NumberValue(big.NewFloat(1.2)),
+				State:          testState("old"),
+				StateValue:     types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"dependency-invalid-expression": {
+			expressions: []path.Expression{path.MatchRoot("invalid")},
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.NumberValue(big.NewFloat(1.2)),
+				State:          testState("old"),
+				StateValue:     types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				Plan: testPlan("new"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Path Expression for Schema",
+						"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+							"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path Expression: invalid",
+					),
+				},
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"configured": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberValue(big.NewFloat(1.2)),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.NumberValue(big.NewFloat(1.2)),
+				State:          testState("old"),
+				StateValue:     types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"resource-create": {
+			expressions: []path.Expression{path.MatchRoot("input")},
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan("new"),
+				PlanValue:      types.NumberValue(big.NewFloat(1.2)),
+				State: tfsdk.State{
+					Schema: testSchema,
+					Raw:    tftypes.NewValue(testType, nil),
+				},
+				StateValue: types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				Plan:      testPlan("new"),
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.NumberResponse{
+				Plan:      testCase.request.Plan,
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			if testCase.responsePlan != nil {
+				resp.Plan = *testCase.responsePlan
+			}
+
+			numberplanmodifier.MarkUnknownIfChanged(testCase.expressions...).PlanModifyNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = ObjectAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = ObjectAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ObjectAttribute{}
 	_ fwschema.AttributeWithObjectDefaultValue     = ObjectAttribute{}
 	_ fwxschema.AttributeWithObjectPlanModifiers   = ObjectAttribute{}
@@ -81,6 +82,10 @@ type ObjectAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -229,6 +234,11 @@ func (a ObjectAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a ObjectAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ObjectDefaultValue returns the Default field value.
 func (a ObjectAttribute) ObjectDefaultValue() defaults.Object {
 	return a.Default
@@ -249,6 +259,10 @@ func (a ObjectAttribute) ObjectValidators() []validator.Object {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a ObjectAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if a.AttributeTypes == nil && a.CustomType == nil {
 		resp.Diagnostics.Append(fwschema.AttributeMissingAttributeTypesDiag(req.Path))
 	}
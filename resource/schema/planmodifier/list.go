@@ -72,6 +72,23 @@ type ListResponse struct {
 	// requires replacement of the whole resource.
 	RequiresReplace bool
 
+	// Plan is the planned new state for the resource following the
+	// PlanModifyList operation. This field is pre-populated with the plan as
+	// modified by every plan modifier which ran earlier, so each plan modifier
+	// observes the changes of the ones before it. Plan modifiers of the same
+	// attribute run in their declared order, while top level attributes and
+	// blocks run in lexical order of attribute names and then block names.
+	// Use its SetAttribute method to modify the planned value of other
+	// computed attributes.
+	//
+	// Changes to attributes which are not computed are checked after all plan
+	// modifiers of the top level attribute or block, including any nested
+	// attributes, have run, returning an error diagnostic for each change.
+	//
+	// Use PlanValue to modify the planned value of this attribute, which
+	// takes precedence over any value set at its path in Plan.
+	Plan tfsdk.Plan
+
 	// Private is the private state resource data following the PlanModifyList operation.
 	// This field is pre-populated from ListRequest.Private and
 	// can be modified during the resource's PlanModifyList operation.
@@ -175,6 +175,16 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	return diags
 }
 
+// WalkAttributes calls the function for every attribute in the schema,
+// including nested attributes and attributes within blocks, with the path
+// expression matching the attribute values. Collection elements are
+// represented with expression steps matching any element, such as
+// AtAnyListIndex. The order is deterministic, which is useful for
+// documentation generators and linters.
+func (s Schema) WalkAttributes(ctx context.Context, fn func(path.Expression, fwschema.Attribute)) {
+	fwschema.SchemaWalkAttributes(ctx, s, fn)
+}
+
 // schemaAttributes is a resource to fwschema type conversion function.
 func schemaAttributes(attributes map[string]Attribute) map[string]fwschema.Attribute {
 	result := make(map[string]fwschema.Attribute, len(attributes))
@@ -209,3 +219,19 @@ func nonComputedAttributeWithDefaultDiag(path path.Path) diag.Diagnostic {
 			"This is an issue with the provider and should be reported to the provider developers.",
 	)
 }
+
+// computedOnlyAttributeWithValidatorsDiag returns a diagnostic for use when a
+// computed attribute which cannot be configured is using validators. This is
+// a warning rather than an error, since the validators were previously
+// silently ignored and existing providers must continue to work.
+func computedOnlyAttributeWithValidatorsDiag(path path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewWarningDiagnostic(
+		"Schema Using Attribute Validators For Computed-Only Attribute",
+		fmt.Sprintf("Attribute %q should be optional when using validators, otherwise the validators are never called. ", path.String())+
+			"Validators only check configuration values, which are always null for attributes that are computed and not optional. "+
+			"This is an issue with the provider and should be reported to the provider developers.",
+	)
+}
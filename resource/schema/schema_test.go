@@ -181,6 +181,37 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 			path:     path.Root("test"),
 			expected: schema.StringAttribute{},
 		},
+		"WithAttributeName-ElementKeyInt-AttributeName-nested-attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list_nested": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_other": schema.BoolAttribute{},
+								"nested_test":  schema.StringAttribute{},
+							},
+						},
+					},
+				},
+			},
+			path:     path.Root("list_nested").AtListIndex(0).AtName("nested_test"),
+			expected: schema.StringAttribute{},
+		},
+		"WithAttributeName-ElementKeyInt-AttributeName-block-attribute": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_test": schema.Int64Attribute{},
+							},
+						},
+					},
+				},
+			},
+			path:     path.Root("list_block").AtListIndex(0).AtName("nested_test"),
+			expected: schema.Int64Attribute{},
+		},
 		"WithAttributeName-block": {
 			schema: schema.Schema{
 				Blocks: map[string]schema.Block{
@@ -793,6 +824,107 @@ func TestSchemaTypeAtPath(t *testing.T) {
 				},
 			},
 		},
+		"AttributeName-ElementKeyInt-ListAttribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListAttribute{
+						ElementType: types.StringType,
+					},
+				},
+			},
+			path:     path.Root("list").AtListIndex(0),
+			expected: types.StringType,
+		},
+		"AttributeName-ElementKeyString-MapAttribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"map": schema.MapAttribute{
+						ElementType: types.Int64Type,
+					},
+				},
+			},
+			path:     path.Root("map").AtMapKey("key"),
+			expected: types.Int64Type,
+		},
+		"AttributeName-ElementKeyInt-ListNestedAttribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list_nested": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_string": schema.StringAttribute{},
+							},
+						},
+					},
+				},
+			},
+			path: path.Root("list_nested").AtListIndex(0),
+			expected: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"nested_string": types.StringType,
+				},
+			},
+		},
+		"AttributeName-ElementKeyValue-SetNestedBlock-AttributeName": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"set_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_bool": schema.BoolAttribute{},
+							},
+						},
+					},
+				},
+			},
+			path: path.Root("set_block").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"nested_bool": types.BoolType,
+				},
+				map[string]attr.Value{
+					"nested_bool": types.BoolValue(true),
+				},
+			)).AtName("nested_bool"),
+			expected: types.BoolType,
+		},
+		"AttributeName-AttributeName-non-existent-nested": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"nested_string": schema.StringAttribute{},
+						},
+					},
+				},
+			},
+			path: path.Root("single_nested").AtName("non_existent"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("single_nested").AtName("non_existent"),
+					"Invalid Schema Path",
+					"When attempting to get the framework type associated with a schema path, an unexpected error was returned. This is always an issue with the provider. Please report this to the provider developers.\n\n"+
+						"Path: single_nested.non_existent\n"+
+						"Original Error: AttributeName(\"non_existent\") still remains in the path: no attribute \"non_existent\" on SingleNestedAttribute",
+				),
+			},
+		},
+		"AttributeName-ElementKeyInt-StringAttribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{},
+				},
+			},
+			path: path.Root("string").AtListIndex(0),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("string").AtListIndex(0),
+					"Invalid Schema Path",
+					"When attempting to get the framework type associated with a schema path, an unexpected error was returned. This is always an issue with the provider. Please report this to the provider developers.\n\n"+
+						"Path: string[0]\n"+
+						"Original Error: ElementKeyInt(0) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyInt to basetypes.StringType",
+				),
+			},
+		},
 		"AttributeName-non-existent": {
 			schema: schema.Schema{},
 			path:   path.Root("non-existent"),
@@ -1094,6 +1226,49 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-required-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Required: true,
+						Computed: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has Required set alongside Optional or Computed. "+
+						"Required attributes cannot also be Optional or Computed.",
+				),
+			},
+		},
+		"nested-attribute-required-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test": schema.StringAttribute{
+								Required: true,
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test\" has Required set alongside Optional or Computed. "+
+						"Required attributes cannot also be Optional or Computed.",
+				),
+			},
+		},
 		"nested-attribute-using-nested-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -1252,6 +1427,91 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"nested-block-nested-attribute-required-computed": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list_nested_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"single_nested_attribute": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"test": schema.StringAttribute{
+											Required: true,
+											Computed: true,
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"list_nested_block.single_nested_attribute.test\" has Required set alongside Optional or Computed. "+
+						"Required attributes cannot also be Optional or Computed.",
+				),
+			},
+		},
+		"nested-attribute-nested-attribute-using-period-field-name": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list_nested_attribute": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"map_nested_attribute": schema.MapNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"test.name": schema.StringAttribute{
+												Optional: true,
+											},
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test.name\" at schema path \"list_nested_attribute.map_nested_attribute.test.name\" is an invalid attribute/block name. "+
+						"Names must only contain lowercase alphanumeric characters (a-z, 0-9) and underscores (_).",
+				),
+			},
+		},
+		"nested-attribute-using-empty-field-name": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"\" at schema path \"single_nested_attribute.\" is an invalid attribute/block name. "+
+						"Names must only contain lowercase alphanumeric characters (a-z, 0-9) and underscores (_).",
+				),
+			},
+		},
 		"attribute-with-validate-attribute-implementation-error": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -1364,3 +1624,99 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaWalkAttributes(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"b_string": schema.StringAttribute{
+				Optional: true,
+			},
+			"a_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nested_string": schema.StringAttribute{
+							Optional: true,
+						},
+						"nested_single": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"deep_bool": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"c_map_nested": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nested_int64": schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"list_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"block_string": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"single_block": schema.SingleNestedBlock{
+							Attributes: map[string]schema.Attribute{
+								"inner_string": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"set_block": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"block_bool": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := path.Expressions{
+		path.MatchRoot("a_list_nested"),
+		path.MatchRoot("a_list_nested").AtAnyListIndex().AtName("nested_single"),
+		path.MatchRoot("a_list_nested").AtAnyListIndex().AtName("nested_single").AtName("deep_bool"),
+		path.MatchRoot("a_list_nested").AtAnyListIndex().AtName("nested_string"),
+		path.MatchRoot("b_string"),
+		path.MatchRoot("c_map_nested"),
+		path.MatchRoot("c_map_nested").AtAnyMapKey().AtName("nested_int64"),
+		path.MatchRoot("list_block").AtAnyListIndex().AtName("block_string"),
+		path.MatchRoot("list_block").AtAnyListIndex().AtName("single_block").AtName("inner_string"),
+		path.MatchRoot("set_block").AtAnySetValue().AtName("block_bool"),
+	}
+
+	var got path.Expressions
+
+	testSchema.WalkAttributes(context.Background(), func(expression path.Expression, attribute fwschema.Attribute) {
+		if attribute == nil {
+			t.Errorf("unexpected nil attribute at %s", expression)
+		}
+
+		got = append(got, expression)
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = SetAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = SetAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = SetAttribute{}
 	_ fwschema.AttributeWithSetDefaultValue        = SetAttribute{}
 	_ fwxschema.AttributeWithSetPlanModifiers      = SetAttribute{}
@@ -77,6 +78,10 @@ type SetAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -225,6 +230,11 @@ func (a SetAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a SetAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // SetDefaultValue returns the Default field value.
 func (a SetAttribute) SetDefaultValue() defaults.Set {
 	return a.Default
@@ -245,6 +255,10 @@ func (a SetAttribute) SetValidators() []validator.Set {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a SetAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if a.CustomType == nil && a.ElementType == nil {
 		resp.Diagnostics.Append(fwschema.AttributeMissingElementTypeDiag(req.Path))
 	}
@@ -22,6 +22,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                              = SingleNestedAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = SingleNestedAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = SingleNestedAttribute{}
 	_ fwschema.AttributeWithObjectDefaultValue     = SingleNestedAttribute{}
 	_ fwxschema.AttributeWithObjectPlanModifiers   = SingleNestedAttribute{}
@@ -83,6 +84,10 @@ type SingleNestedAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -271,6 +276,11 @@ func (a SingleNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a SingleNestedAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // ObjectDefaultValue returns the Default field value.
 func (a SingleNestedAttribute) ObjectDefaultValue() defaults.Object {
 	return a.Default
@@ -291,6 +301,10 @@ func (a SingleNestedAttribute) ObjectValidators() []validator.Object {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a SingleNestedAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.ObjectDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = StringAttribute{}
+	_ fwschema.AttributeWithLogSensitive           = StringAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = StringAttribute{}
 	_ fwschema.AttributeWithStringDefaultValue     = StringAttribute{}
 	_ fwxschema.AttributeWithStringPlanModifiers   = StringAttribute{}
@@ -68,6 +69,10 @@ type StringAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// LogSensitive masks string values of this attribute in logs and
+	// diagnostics during validation and plan modification, as Sensitive does.
+	LogSensitive bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -214,6 +219,11 @@ func (a StringAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsLogSensitive returns the LogSensitive field value.
+func (a StringAttribute) IsLogSensitive() bool {
+	return a.LogSensitive
+}
+
 // StringDefaultValue returns the Default field value.
 func (a StringAttribute) StringDefaultValue() defaults.String {
 	return a.Default
@@ -234,6 +244,10 @@ func (a StringAttribute) StringValidators() []validator.String {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a StringAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsComputed() && !a.IsOptional() && len(a.Validators) > 0 {
+		resp.Diagnostics.Append(computedOnlyAttributeWithValidatorsDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.StringDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// IgnoreCase returns a plan modifier that copies a known prior state value
+// into the planned value when both values are equal after converting them to
+// lowercase. Use this when the remote system treats the value as
+// case-insensitive and may return it with different casing than configured,
+// to prevent perpetual differences in the plan.
+//
+// Values which differ in more than casing are left unchanged. This plan
+// modifier only affects updates, since there is no prior state value during
+// creation. If the remote system changes the value casing during creation,
+// use a custom type which implements semantic equality instead.
+func IgnoreCase() planmodifier.String {
+	return ignoreCaseModifier{}
+}
+
+// ignoreCaseModifier implements the plan modifier.
+type ignoreCaseModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m ignoreCaseModifier) Description(_ context.Context) string {
+	return "Differences in letter case between the configured and prior state value are ignored."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m ignoreCaseModifier) MarkdownDescription(_ context.Context) string {
+	return "Differences in letter case between the configured and prior state value are ignored."
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m ignoreCaseModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is no state value.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is no known planned value.
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if the values are already exactly equal.
+	if req.PlanValue.ValueString() == req.StateValue.ValueString() {
+		return
+	}
+
+	if strings.ToLower(req.PlanValue.ValueString()) != strings.ToLower(req.StateValue.ValueString()) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIgnoreCaseModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"null-state": {
+			// resource creation, nothing to compare against
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringValue("US-EAST-1"),
+				ConfigValue: types.StringValue("US-EAST-1"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("US-EAST-1"),
+			},
+		},
+		"null-plan": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("us-east-1"),
+				PlanValue:   types.StringNull(),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("us-east-1"),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"equal": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("us-east-1"),
+				PlanValue:   types.StringValue("us-east-1"),
+				ConfigValue: types.StringValue("us-east-1"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("us-east-1"),
+			},
+		},
+		"mixed-case-equal": {
+			// this is the situation we want to suppress the difference
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("us-east-1"),
+				PlanValue:   types.StringValue("US-East-1"),
+				ConfigValue: types.StringValue("US-East-1"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("us-east-1"),
+			},
+		},
+		"mixed-case-equal-required": {
+			// the difference is also suppressed for attributes which are not computed
+			request: planmodifier.StringRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"attr": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"attr": tftypes.NewValue(tftypes.String, "US-East-1"),
+					}),
+					Schema: schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"attr": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				Path:        path.Root("attr"),
+				StateValue:  types.StringValue("us-east-1"),
+				PlanValue:   types.StringValue("US-East-1"),
+				ConfigValue: types.StringValue("US-East-1"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("us-east-1"),
+			},
+		},
+		"mixed-case-unequal": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("us-east-1"),
+				PlanValue:   types.StringValue("US-WEST-2"),
+				ConfigValue: types.StringValue("US-WEST-2"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("US-WEST-2"),
+			},
+		},
+		"same-case-unequal": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("us-east-1"),
+				PlanValue:   types.StringValue("us-east-2"),
+				ConfigValue: types.StringValue("us-east-2"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("us-east-2"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.IgnoreCase().PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -144,6 +144,36 @@ func TestRequiresReplaceIfModifierPlanModifyString(t *testing.T) {
 				RequiresReplace: true,
 			},
 		},
+		"planvalue-statevalue-set-to-unset": {
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringNull()),
+				PlanValue:  types.StringNull(),
+				State:      testState(types.StringValue("test")),
+				StateValue: types.StringValue("test"),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull() // should reach here
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue:       types.StringNull(),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-statevalue-unset-to-set": {
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringValue("test")),
+				PlanValue:  types.StringValue("test"),
+				State:      testState(types.StringNull()),
+				StateValue: types.StringNull(),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull() // should reach here
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue:       types.StringValue("test"),
+				RequiresReplace: false,
+			},
+		},
 		"planvalue-statevalue-equal": {
 			request: planmodifier.StringRequest{
 				Plan:       testPlan(types.StringValue("test")),
@@ -4,8 +4,13 @@
 package resource
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -39,6 +44,63 @@ type UpdateRequest struct {
 	Private *privatestate.ProviderData
 }
 
+// Changed returns true if the planned value at the given path differs from
+// the prior state value at the same path. This enables Update logic to only
+// send changed values to remote systems.
+//
+// Planned values which are unknown, or contain unknown values, are always
+// considered changed. Values are otherwise compared with their Equal method,
+// which for sets is based on membership rather than element order, and with
+// any semantic equality logic implemented by custom value types.
+func (r UpdateRequest) Changed(ctx context.Context, p path.Path) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var planValue, stateValue attr.Value
+
+	diags.Append(r.Plan.GetAttribute(ctx, p, &planValue)...)
+	diags.Append(r.State.GetAttribute(ctx, p, &stateValue)...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	planTfValue, err := planValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Plan Read Error",
+			"An unexpected error was encountered trying to convert the planned value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	if !planTfValue.IsFullyKnown() {
+		return true, diags
+	}
+
+	if planValue.Equal(stateValue) {
+		return false, diags
+	}
+
+	semanticEqualityReq := fwschemadata.ValueSemanticEqualityRequest{
+		Path:             p,
+		PriorValue:       stateValue,
+		ProposedNewValue: planValue,
+	}
+	semanticEqualityResp := &fwschemadata.ValueSemanticEqualityResponse{}
+
+	fwschemadata.ValueSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+	diags.Append(semanticEqualityResp.Diagnostics...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return !semanticEqualityResp.NewValue.Equal(stateValue), diags
+}
+
 // UpdateResponse represents a response to an UpdateRequest. An
 // instance of this response struct is supplied as
 // an argument to the resource's Update function, in which the provider
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpdateRequestChanged(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_set": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"test_string": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_set":    tftypes.Set{ElementType: tftypes.String},
+			"test_string": tftypes.String,
+		},
+	}
+
+	testValue := func(setElements []tftypes.Value, stringValue any) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_set":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, setElements),
+			"test_string": tftypes.NewValue(tftypes.String, stringValue),
+		})
+	}
+
+	testCases := map[string]struct {
+		request       resource.UpdateRequest
+		path          path.Path
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"string-unchanged": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testValue(nil, "test-value"),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue(nil, "test-value"),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_string"),
+			expected: false,
+		},
+		"string-changed": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testValue(nil, "test-new-value"),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue(nil, "test-value"),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_string"),
+			expected: true,
+		},
+		"string-changed-null": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testValue(nil, nil),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue(nil, "test-value"),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_string"),
+			expected: true,
+		},
+		"string-unknown": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testValue(nil, tftypes.UnknownValue),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue(nil, "test-value"),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_string"),
+			expected: true,
+		},
+		"set-reordered": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "two"),
+							tftypes.NewValue(tftypes.String, "one"),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, "two"),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_set"),
+			expected: false,
+		},
+		"set-changed": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, "three"),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, "two"),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_set"),
+			expected: true,
+		},
+		"set-element-unknown": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, "two"),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_set"),
+			expected: true,
+		},
+		"set-element-unknown-equal": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw: testValue(
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						},
+						nil,
+					),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("test_set"),
+			expected: true,
+		},
+		"invalid-path": {
+			request: resource.UpdateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testValue(nil, "test-value"),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue(nil, "test-value"),
+					Schema: testSchema,
+				},
+			},
+			path:     path.Root("not_in_schema"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("not_in_schema"),
+					"Plan Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"not_in_schema\") still remains in the path: could not find attribute or block \"not_in_schema\" in schema",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("not_in_schema"),
+					"State Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"not_in_schema\") still remains in the path: could not find attribute or block \"not_in_schema\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.request.Changed(context.Background(), testCase.path)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
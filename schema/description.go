@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+// DescriptionKind is the format of a description.
+type DescriptionKind int
+
+const (
+	// DescriptionKindPlain represents a plaintext description.
+	DescriptionKindPlain DescriptionKind = 0
+
+	// DescriptionKindMarkdown represents a Markdown formatted description.
+	DescriptionKindMarkdown DescriptionKind = 1
+)
+
+// Describer is the description interface shared by all data source,
+// provider, and resource schemas, attributes, and blocks.
+type Describer interface {
+	// GetDescription should return a non-empty string if a plaintext
+	// description is defined.
+	GetDescription() string
+
+	// GetMarkdownDescription should return a non-empty string if a Markdown
+	// description is defined.
+	GetMarkdownDescription() string
+}
+
+// EffectiveDescription returns the description which is sent to Terraform and
+// its kind. If both descriptions are set, the Markdown description takes
+// precedence. If neither is set, an empty plaintext description is returned.
+//
+// This is intended for development tooling, such as documentation
+// generators, which should follow the same precedence as Terraform.
+func EffectiveDescription(d Describer) (string, DescriptionKind) {
+	if d.GetMarkdownDescription() != "" {
+		return d.GetMarkdownDescription(), DescriptionKindMarkdown
+	}
+
+	return d.GetDescription(), DescriptionKindPlain
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+)
+
+func TestEffectiveDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		describer           schema.Describer
+		expectedDescription string
+		expectedKind        schema.DescriptionKind
+	}{
+		"none": {
+			describer:           resourceschema.StringAttribute{},
+			expectedDescription: "",
+			expectedKind:        schema.DescriptionKindPlain,
+		},
+		"description": {
+			describer: resourceschema.StringAttribute{
+				Description: "test description",
+			},
+			expectedDescription: "test description",
+			expectedKind:        schema.DescriptionKindPlain,
+		},
+		"markdown-description": {
+			describer: datasourceschema.ListNestedBlock{
+				MarkdownDescription: "test *markdown* description",
+			},
+			expectedDescription: "test *markdown* description",
+			expectedKind:        schema.DescriptionKindMarkdown,
+		},
+		"both": {
+			describer: resourceschema.Schema{
+				Description:         "test description",
+				MarkdownDescription: "test *markdown* description",
+			},
+			expectedDescription: "test *markdown* description",
+			expectedKind:        schema.DescriptionKindMarkdown,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDescription, gotKind := schema.EffectiveDescription(testCase.describer)
+
+			if diff := cmp.Diff(gotDescription, testCase.expectedDescription); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotKind, testCase.expectedKind); diff != "" {
+				t.Errorf("unexpected kind difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Attribute is the attribute implementation accepted by ValidateAttribute.
+// Implementations include the attribute types in the datasource/schema,
+// provider/schema, and resource/schema packages, such as StringAttribute.
+type Attribute interface {
+	fwschema.Attribute
+}
+
+// ValidateAttribute runs the type validation and the Validators of the
+// attribute, such as a datasource/schema, provider/schema, or resource/schema
+// StringAttribute, against the given value and returns any diagnostics. The
+// attribute path is passed to validators and used in diagnostics.
+//
+// Validators receive a Config which only contains this attribute and value.
+// When the path is a root attribute path, such as path.Root("example"), the
+// attribute is available in the Config at that path. Otherwise the Config
+// contains no attributes. Reading any other configuration values, such as
+// with path based validators, returns an error diagnostic since the rest of
+// the configuration is not available. Nested attributes are not validated.
+func ValidateAttribute(ctx context.Context, attribute Attribute, attributePath path.Path, value tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attrType := attribute.GetType()
+
+	if !value.Type().Equal(attrType.TerraformType(ctx)) {
+		diags.AddAttributeError(
+			attributePath,
+			"Attribute Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. "+
+				fmt.Sprintf("The value type %s does not match the attribute type %s.", value.Type(), attrType.TerraformType(ctx)),
+		)
+
+		return diags
+	}
+
+	if attrTypeWithValidate, ok := attrType.(xattr.TypeWithValidate); ok {
+		diags.Append(attrTypeWithValidate.Validate(ctx, value, attributePath)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	attributeValue, err := attrType.ValueFromTerraform(ctx, value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Attribute Validation Error",
+			"An unexpected error was encountered trying to convert an attribute value. "+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	req := fwserver.ValidateAttributeRequest{
+		AttributeConfig:         attributeValue,
+		AttributePath:           attributePath,
+		AttributePathExpression: attributePath.Expression(),
+		Config:                  validateAttributeConfig(ctx, attribute, attributePath, value),
+	}
+	resp := &fwserver.ValidateAttributeResponse{}
+
+	fwserver.AttributeValidateValidators(ctx, attribute, req, resp)
+
+	diags.Append(resp.Diagnostics...)
+
+	return diags
+}
+
+// validateAttributeConfig returns a Config containing only the given attribute
+// and value at the path, if the path is a root attribute path, so validators
+// reading the Config receive diagnostics rather than panicking.
+func validateAttributeConfig(ctx context.Context, attribute Attribute, attributePath path.Path, value tftypes.Value) tfsdk.Config {
+	configSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{},
+	}
+	configValues := map[string]tftypes.Value{}
+
+	if steps := attributePath.Steps(); len(steps) == 1 {
+		if name, ok := steps[0].(path.PathStepAttributeName); ok {
+			configSchema.Attributes[string(name)] = attribute
+			configValues[string(name)] = value
+		}
+	}
+
+	return tfsdk.Config{
+		Raw:    tftypes.NewValue(configSchema.Type().TerraformType(ctx), configValues),
+		Schema: configSchema,
+	}
+}
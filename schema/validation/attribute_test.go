@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validation"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	// stringLengthAtLeast mirrors a typical string length validator.
+	stringLengthAtLeast := func(minLength int) validator.String {
+		return testvalidator.String{
+			ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+				if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+					return
+				}
+
+				if length := len(req.ConfigValue.ValueString()); length < minLength {
+					resp.Diagnostics.AddAttributeError(
+						req.Path,
+						"Invalid Attribute Value Length",
+						fmt.Sprintf("Attribute %s string length must be at least %d, got: %d", req.Path, minLength, length),
+					)
+				}
+			},
+		}
+	}
+
+	testAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringLengthAtLeast(3),
+		},
+	}
+
+	// configAttributeEqual mirrors a validator which reads the configuration.
+	configAttributeEqual := func(attributePath path.Path) validator.String {
+		return testvalidator.String{
+			ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+				var configValue types.String
+
+				resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attributePath, &configValue)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if !configValue.Equal(req.ConfigValue) {
+					resp.Diagnostics.AddAttributeError(
+						req.Path,
+						"Unexpected Config Value",
+						fmt.Sprintf("Expected %s, got: %s", req.ConfigValue, configValue),
+					)
+				}
+			},
+		}
+	}
+
+	testConfigAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			configAttributeEqual(path.Root("test")),
+		},
+	}
+
+	testConfigOtherAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			configAttributeEqual(path.Root("other")),
+		},
+	}
+
+	testCases := map[string]struct {
+		attribute     validation.Attribute
+		path          path.Path
+		value         tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			attribute: testAttribute,
+			path:      path.Root("test"),
+			value:     tftypes.NewValue(tftypes.String, "test-value"),
+		},
+		"invalid": {
+			attribute: testAttribute,
+			path:      path.Root("test"),
+			value:     tftypes.NewValue(tftypes.String, "t"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at least 3, got: 1",
+				),
+			},
+		},
+		"invalid-nested-path": {
+			attribute: testAttribute,
+			path:      path.Root("test").AtListIndex(1).AtName("nested"),
+			value:     tftypes.NewValue(tftypes.String, "t"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1).AtName("nested"),
+					"Invalid Attribute Value Length",
+					"Attribute test[1].nested string length must be at least 3, got: 1",
+				),
+			},
+		},
+		"null": {
+			attribute: testAttribute,
+			path:      path.Root("test"),
+			value:     tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			attribute: testAttribute,
+			path:      path.Root("test"),
+			value:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"config": {
+			attribute: testConfigAttribute,
+			path:      path.Root("test"),
+			value:     tftypes.NewValue(tftypes.String, "test-value"),
+		},
+		"config-nested-path": {
+			attribute: testConfigAttribute,
+			path:      path.Root("test").AtListIndex(1).AtName("nested"),
+			value:     tftypes.NewValue(tftypes.String, "test-value"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Configuration Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"test\") still remains in the path: could not find attribute or block \"test\" in schema",
+				),
+			},
+		},
+		"config-other-attribute": {
+			attribute: testConfigOtherAttribute,
+			path:      path.Root("test"),
+			value:     tftypes.NewValue(tftypes.String, "test-value"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Configuration Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
+				),
+			},
+		},
+		"type-mismatch": {
+			attribute: testAttribute,
+			path:      path.Root("test"),
+			value:     tftypes.NewValue(tftypes.Bool, true),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Attribute Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. "+
+						"The value type tftypes.Bool does not match the attribute type tftypes.String.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validation.ValidateAttribute(context.Background(), testCase.attribute, testCase.path, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validation contains functionality for running schema validation
+// outside of the framework server, such as in unit tests for validators or
+// in tooling which checks values before they are sent to Terraform.
+package validation
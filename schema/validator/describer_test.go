@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Every validator interface must include Describer, so provider logging and
+// documentation tooling can always collect validator descriptions.
+var (
+	_ validator.Describer = validator.Bool(nil)
+	_ validator.Describer = validator.Float64(nil)
+	_ validator.Describer = validator.Int64(nil)
+	_ validator.Describer = validator.List(nil)
+	_ validator.Describer = validator.Map(nil)
+	_ validator.Describer = validator.Number(nil)
+	_ validator.Describer = validator.Object(nil)
+	_ validator.Describer = validator.Set(nil)
+	_ validator.Describer = validator.String(nil)
+)
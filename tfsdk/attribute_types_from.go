@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AttributeTypesFrom infers the attribute types of the Go struct `val`, or a
+// pointer to it, such as for generating a schema or types.ObjectType from an
+// existing model. Field names are taken from the `tfsdk` struct tags, the
+// same as when reading or setting data with the struct.
+//
+// Go types are inferred as:
+//
+//   - bool as types.BoolType
+//   - signed and unsigned integers as types.Int64Type
+//   - float32 and float64 as types.Float64Type
+//   - *big.Float and *big.Int as types.NumberType
+//   - string and time.Time as types.StringType
+//   - slices as types.ListType
+//   - maps with string keys as types.MapType
+//   - structs as types.ObjectType
+//   - attr.Value implementations as the type of their zero value
+//
+// Pointers are inferred as the type they point to. An error diagnostic is
+// returned for any other Go type, or for an attr.Value implementation of a
+// collection or object type, since element and attribute types cannot be
+// inferred from its zero value. Diagnostics for collection element types are
+// associated with the path of the collection. Recursive struct types, such as
+// a struct with a field pointing to the same struct type, also return an
+// error diagnostic since object types cannot be recursive.
+//
+// This is intended for development tooling, such as code generators. The
+// result does not include whether each attribute is required, optional, or
+// computed, so it cannot be used as a schema definition directly.
+func AttributeTypesFrom(ctx context.Context, val interface{}) (map[string]attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	typ := reflect.TypeOf(val)
+
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		diags.AddError(
+			"Unsupported Go Type",
+			fmt.Sprintf("Unable to infer attribute types from Go type %T. Attribute types can only be inferred from a struct or pointer to a struct.", val),
+		)
+
+		return nil, diags
+	}
+
+	return attributeTypesFrom(ctx, typ, path.Empty(), map[reflect.Type]struct{}{})
+}
+
+// attributeTypesFrom infers the attribute types of the struct type `typ`.
+// The `visiting` struct types are those currently being inferred further up
+// the path, which are used to detect recursive struct types.
+func attributeTypesFrom(ctx context.Context, typ reflect.Type, p path.Path, visiting map[reflect.Type]struct{}) (map[string]attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, ok := visiting[typ]; ok {
+		diags.AddAttributeError(
+			p,
+			"Unsupported Go Type",
+			fmt.Sprintf("Unable to infer attribute types from Go type %s. The struct type is recursive, which cannot be represented as an object type.", typ),
+		)
+
+		return nil, diags
+	}
+
+	visiting[typ] = struct{}{}
+
+	defer delete(visiting, typ)
+
+	tags, err := fwreflect.StructTags(ctx, typ, p)
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Unsupported Go Type",
+			fmt.Sprintf("Unable to infer attribute types from Go type %s: %s", typ, err),
+		)
+
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(tags))
+
+	for name := range tags {
+		names = append(names, name)
+	}
+
+	// Sort names so any diagnostics are returned in a consistent order.
+	sort.Strings(names)
+
+	attrTypes := make(map[string]attr.Type, len(tags))
+
+	for _, name := range names {
+		attrType, attrTypeDiags := typeFrom(ctx, typ.FieldByIndex(tags[name]).Type, p.AtName(name), visiting)
+
+		diags.Append(attrTypeDiags...)
+
+		attrTypes[name] = attrType
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return attrTypes, diags
+}
+
+// typeFrom infers the attr.Type which holds values of the Go type `typ`.
+func typeFrom(ctx context.Context, typ reflect.Type, p path.Path, visiting map[reflect.Type]struct{}) (attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typ.Kind() == reflect.Ptr {
+		return typeFrom(ctx, typ.Elem(), p, visiting)
+	}
+
+	if typ.Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
+		attrType := reflect.Zero(typ).Interface().(attr.Value).Type(ctx)
+
+		switch attrType.(type) {
+		case attr.TypeWithAttributeTypes, attr.TypeWithElementType, attr.TypeWithElementTypes:
+			diags.AddAttributeError(
+				p,
+				"Unsupported Go Type",
+				fmt.Sprintf("Unable to infer the type of Go type %s. The element or attribute types of %T values cannot be inferred, use a Go slice, map, or struct type instead.", typ, attrType),
+			)
+
+			return nil, diags
+		}
+
+		return attrType, diags
+	}
+
+	switch typ {
+	case reflect.TypeOf(big.Float{}), reflect.TypeOf(big.Int{}):
+		return basetypes.NumberType{}, diags
+	case reflect.TypeOf(time.Time{}):
+		return basetypes.StringType{}, diags
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return basetypes.BoolType{}, diags
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return basetypes.Int64Type{}, diags
+	case reflect.Float32, reflect.Float64:
+		return basetypes.Float64Type{}, diags
+	case reflect.String:
+		return basetypes.StringType{}, diags
+	case reflect.Slice:
+		elemType, elemTypeDiags := typeFrom(ctx, typ.Elem(), p, visiting)
+
+		diags.Append(elemTypeDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return basetypes.ListType{ElemType: elemType}, diags
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			break
+		}
+
+		elemType, elemTypeDiags := typeFrom(ctx, typ.Elem(), p, visiting)
+
+		diags.Append(elemTypeDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return basetypes.MapType{ElemType: elemType}, diags
+	case reflect.Struct:
+		attrTypes, attrTypesDiags := attributeTypesFrom(ctx, typ, p, visiting)
+
+		diags.Append(attrTypesDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return basetypes.ObjectType{AttrTypes: attrTypes}, diags
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Unsupported Go Type",
+		fmt.Sprintf("Unable to infer the type of Go type %s.", typ),
+	)
+
+	return nil, diags
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttributeTypesFrom(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		Enabled bool     `tfsdk:"enabled"`
+		Tags    []string `tfsdk:"tags"`
+	}
+
+	type node struct {
+		Child *node `tfsdk:"child"`
+	}
+
+	type listNode struct {
+		Children []listNode `tfsdk:"children"`
+	}
+
+	testCases := map[string]struct {
+		val           interface{}
+		expected      map[string]attr.Type
+		expectedDiags diag.Diagnostics
+	}{
+		"primitives": {
+			val: struct {
+				Bool    bool         `tfsdk:"bool"`
+				Float32 float32      `tfsdk:"float32"`
+				Float64 *float64     `tfsdk:"float64"`
+				Ignored string       `tfsdk:"-"`
+				Int     int          `tfsdk:"int"`
+				Int64   *int64       `tfsdk:"int64"`
+				Number  *big.Float   `tfsdk:"number"`
+				String  string       `tfsdk:"string"`
+				Time    time.Time    `tfsdk:"time"`
+				Uint8   uint8        `tfsdk:"uint8"`
+				Value   types.String `tfsdk:"value"`
+			}{},
+			expected: map[string]attr.Type{
+				"bool":    types.BoolType,
+				"float32": types.Float64Type,
+				"float64": types.Float64Type,
+				"int":     types.Int64Type,
+				"int64":   types.Int64Type,
+				"number":  types.NumberType,
+				"string":  types.StringType,
+				"time":    types.StringType,
+				"uint8":   types.Int64Type,
+				"value":   types.StringType,
+			},
+		},
+		"collections": {
+			val: &struct {
+				List      []string            `tfsdk:"list"`
+				ListOfMap []map[string]int64  `tfsdk:"list_of_map"`
+				Map       map[string]*float64 `tfsdk:"map"`
+			}{},
+			expected: map[string]attr.Type{
+				"list": types.ListType{ElemType: types.StringType},
+				"list_of_map": types.ListType{
+					ElemType: types.MapType{ElemType: types.Int64Type},
+				},
+				"map": types.MapType{ElemType: types.Float64Type},
+			},
+		},
+		"nested-struct": {
+			val: struct {
+				ID     string   `tfsdk:"id"`
+				Nested nested   `tfsdk:"nested"`
+				List   []nested `tfsdk:"list"`
+			}{},
+			expected: map[string]attr.Type{
+				"id": types.StringType,
+				"list": types.ListType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"enabled": types.BoolType,
+							"tags":    types.ListType{ElemType: types.StringType},
+						},
+					},
+				},
+				"nested": types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"enabled": types.BoolType,
+						"tags":    types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+		},
+		"embedded-struct": {
+			val: struct {
+				nested
+				ID string `tfsdk:"id"`
+			}{},
+			expected: map[string]attr.Type{
+				"enabled": types.BoolType,
+				"id":      types.StringType,
+				"tags":    types.ListType{ElemType: types.StringType},
+			},
+		},
+		"repeated-struct": {
+			val: struct {
+				First  nested `tfsdk:"first"`
+				Second nested `tfsdk:"second"`
+			}{},
+			expected: map[string]attr.Type{
+				"first": types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"enabled": types.BoolType,
+						"tags":    types.ListType{ElemType: types.StringType},
+					},
+				},
+				"second": types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"enabled": types.BoolType,
+						"tags":    types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+		},
+		"recursive-struct": {
+			val: node{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("child"),
+					"Unsupported Go Type",
+					"Unable to infer attribute types from Go type tfsdk_test.node. The struct type is recursive, which cannot be represented as an object type.",
+				),
+			},
+		},
+		"recursive-struct-list": {
+			val: &listNode{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("children"),
+					"Unsupported Go Type",
+					"Unable to infer attribute types from Go type tfsdk_test.listNode. The struct type is recursive, which cannot be represented as an object type.",
+				),
+			},
+		},
+		"not-struct": {
+			val: "test",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unsupported Go Type",
+					"Unable to infer attribute types from Go type string. Attribute types can only be inferred from a struct or pointer to a struct.",
+				),
+			},
+		},
+		"missing-tag": {
+			val: struct {
+				Name string
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Unsupported Go Type",
+					"Unable to infer attribute types from Go type struct { Name string }: "+
+						`: need a struct tag for "tfsdk" on Name, or a tfsdk:"-" struct tag to ignore the field`,
+				),
+			},
+		},
+		"unsupported-types": {
+			val: struct {
+				Channel chan string      `tfsdk:"channel"`
+				List    types.List       `tfsdk:"list"`
+				Map     map[int64]string `tfsdk:"map"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("channel"),
+					"Unsupported Go Type",
+					"Unable to infer the type of Go type chan string.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list"),
+					"Unsupported Go Type",
+					"Unable to infer the type of Go type basetypes.ListValue. The element or attribute types of basetypes.ListType values cannot be inferred, use a Go slice, map, or struct type instead.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("map"),
+					"Unsupported Go Type",
+					"Unable to infer the type of Go type map[int64]string.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.AttributeTypesFrom(context.Background(), testCase.val)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package tfsdk
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -15,7 +16,13 @@ import (
 
 // Config represents a Terraform config.
 type Config struct {
-	Raw    tftypes.Value
+	// Raw is the untyped config data. It is an advanced escape hatch for
+	// values the typed Get methods cannot express, such as unusual or
+	// dynamic shapes. Most providers should not need it. Config data should
+	// be treated as read-only.
+	Raw tftypes.Value
+
+	// Schema describes the config data.
 	Schema fwschema.Schema
 }
 
@@ -35,6 +42,44 @@ func (c Config) GetAttribute(ctx context.Context, path path.Path, target interfa
 	return c.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeValue retrieves the attribute or block found at `path` and
+// returns it as its concrete attr.Value implementation, such as types.String,
+// based on the schema type at that path. This enables checking whether the
+// value is null or unknown before converting it further.
+//
+// Attributes or elements under null or unknown collections return null
+// values, however this behavior is not protected by compatibility promises.
+func (c Config) GetAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
+	return c.data().ValueAtPath(ctx, path)
+}
+
+// PathExists returns true if the path is set in the configuration, meaning
+// its value is not null. An unknown value is considered set, since it will
+// be known during apply. Use PathIsUnknown to distinguish unknown values.
+//
+// False is returned rather than an error if a parent value is null, such as
+// an unconfigured nested attribute object, or if the path cannot be reached,
+// such as a list index beyond the list length. An error diagnostic is
+// returned if the path is not in the schema.
+func (c Config) PathExists(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	null, _, diags := c.data().PathNullOrUnknown(ctx, path)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return !null, diags
+}
+
+// PathIsUnknown returns true if the value at the path, or any parent value,
+// is unknown. An error diagnostic is returned if the path is not in the
+// schema.
+func (c Config) PathIsUnknown(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	_, unknown, diags := c.data().PathNullOrUnknown(ctx, path)
+
+	return unknown, diags
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -200,6 +201,216 @@ func TestConfigGetAttribute(t *testing.T) {
 	}
 }
 
+func TestConfigGetAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		config        tfsdk.Config
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataValueAtPath for more exhaustive unit
+		// testing. These test cases are to ensure Config schema and data values
+		// are passed appropriately to the shared implementation.
+		"valid": {
+			config: tfsdk.Config{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "namevalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: types.StringValue("namevalue"),
+		},
+		"unknown": {
+			config: tfsdk.Config{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: types.StringUnknown(),
+		},
+		"diagnostics": {
+			config: tfsdk.Config{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "namevalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     testtypes.StringTypeWithValidateWarning{},
+							Required: true,
+						},
+					},
+				},
+			},
+			expected:      testtypes.String{InternalString: types.StringValue("namevalue"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.config.GetAttributeValue(context.Background(), path.Root("name"))
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigPathExists(t *testing.T) {
+	t.Parallel()
+
+	testConfig := func(value tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested": tftypes.String,
+						},
+					},
+				},
+			}, map[string]tftypes.Value{
+				"test": value,
+			}),
+			Schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Type: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested": types.StringType,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+
+	type testCase struct {
+		config          tfsdk.Config
+		path            path.Path
+		expectedExists  bool
+		expectedUnknown bool
+		expectedDiags   diag.Diagnostics
+	}
+
+	// Refer to fwschemadata.TestDataPathNullOrUnknown for more exhaustive
+	// unit testing. These test cases are to ensure Config schema and data
+	// values are passed appropriately to the shared implementation.
+	testCases := map[string]testCase{
+		"known": {
+			config: testConfig(tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tftypes.String, "test"),
+			})),
+			path:           path.Root("test").AtName("nested"),
+			expectedExists: true,
+		},
+		"null": {
+			config: testConfig(tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tftypes.String, nil),
+			})),
+			path: path.Root("test").AtName("nested"),
+		},
+		"unknown": {
+			config: testConfig(tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})),
+			path:            path.Root("test").AtName("nested"),
+			expectedExists:  true,
+			expectedUnknown: true,
+		},
+		"parent-null": {
+			config: testConfig(tftypes.NewValue(testObjectType, nil)),
+			path:   path.Root("test").AtName("nested"),
+		},
+		"invalid-path": {
+			config: testConfig(tftypes.NewValue(testObjectType, nil)),
+			path:   path.Root("test").AtName("other"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtName("other"),
+					"Configuration Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: undefined attribute name other in ObjectType",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotExists, diags := tc.config.PathExists(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected PathExists diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if gotExists != tc.expectedExists {
+				t.Errorf("expected PathExists %t, got %t", tc.expectedExists, gotExists)
+			}
+
+			gotUnknown, diags := tc.config.PathIsUnknown(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected PathIsUnknown diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if gotUnknown != tc.expectedUnknown {
+				t.Errorf("expected PathIsUnknown %t, got %t", tc.expectedUnknown, gotUnknown)
+			}
+		})
+	}
+}
+
 func TestConfigPathMatches(t *testing.T) {
 	t.Parallel()
 
@@ -289,3 +500,54 @@ func TestConfigPathMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigRaw(t *testing.T) {
+	t.Parallel()
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"string": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "test"),
+			},
+		),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"string": testschema.Attribute{
+					Optional: true,
+					Type:     types.StringType,
+				},
+			},
+		},
+	}
+
+	var typed struct {
+		String types.String `tfsdk:"string"`
+	}
+
+	diags := config.Get(context.Background(), &typed)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+
+	var raw map[string]tftypes.Value
+
+	if err := config.Raw.As(&raw); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var rawString string
+
+	if err := raw["string"].As(&rawString); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(rawString, typed.String.ValueString()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -17,7 +18,14 @@ import (
 
 // State represents a Terraform state.
 type State struct {
-	Raw    tftypes.Value
+	// Raw is the untyped state data. It is an advanced escape hatch for
+	// values the typed Get methods cannot express, such as unusual or
+	// dynamic shapes. Most providers should not need it. Prefer the Set and
+	// SetAttribute methods for updating the state, which keep Raw consistent
+	// with the Schema.
+	Raw tftypes.Value
+
+	// Schema describes the state data.
 	Schema fwschema.Schema
 }
 
@@ -37,6 +45,17 @@ func (s State) GetAttribute(ctx context.Context, path path.Path, target interfac
 	return s.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeValue retrieves the attribute or block found at `path` and
+// returns it as its concrete attr.Value implementation, such as types.String,
+// based on the schema type at that path. This enables checking whether the
+// value is null or unknown before converting it further.
+//
+// Attributes or elements under null or unknown collections return null
+// values, however this behavior is not protected by compatibility promises.
+func (s State) GetAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
+	return s.data().ValueAtPath(ctx, path)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
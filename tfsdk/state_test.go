@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -200,6 +201,169 @@ func TestStateGetAttribute(t *testing.T) {
 	}
 }
 
+func TestStateGetAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		state         tfsdk.State
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataValueAtPath for more exhaustive unit
+		// testing. These test cases are to ensure State schema and data values
+		// are passed appropriately to the shared implementation.
+		"valid": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "namevalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: types.StringValue("namevalue"),
+		},
+		"unknown": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: types.StringUnknown(),
+		},
+		"diagnostics": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "namevalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     testtypes.StringTypeWithValidateWarning{},
+							Required: true,
+						},
+					},
+				},
+			},
+			expected:      testtypes.String{InternalString: types.StringValue("namevalue"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.state.GetAttributeValue(context.Background(), path.Root("name"))
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateRemoveResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state    tfsdk.State
+		expected tftypes.Value
+	}{
+		"known": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "originalname"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, nil),
+		},
+		"null": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.state.RemoveResource(context.Background())
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateSet(t *testing.T) {
 	t.Parallel()
 
@@ -302,6 +466,16 @@ func TestStateSetAttribute(t *testing.T) {
 		expectedDiags diag.Diagnostics
 	}
 
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	type testNestedObject struct {
+		ID types.String `tfsdk:"id"`
+	}
+
 	testCases := map[string]testCase{
 		// Refer to fwschemadata.TestDataSetAtPath for more exhaustive unit
 		// testing. These test cases are to ensure State schema and data values
@@ -342,6 +516,233 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
 			}),
 		},
+		"list-element-replace": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{ElementType: testNestedObjectType},
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+						tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+							"id": tftypes.NewValue(tftypes.String, "one"),
+						}),
+						tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+							"id": tftypes.NewValue(tftypes.String, "two"),
+						}),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"id": testschema.Attribute{
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Required:    true,
+						},
+					},
+				},
+			},
+			path: path.Root("test").AtListIndex(1),
+			val: testNestedObject{
+				ID: types.StringValue("new"),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.List{ElementType: testNestedObjectType},
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "new"),
+					}),
+				}),
+			}),
+		},
+		"list-element-index-error": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{ElementType: testNestedObjectType},
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+						tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+							"id": tftypes.NewValue(tftypes.String, "one"),
+						}),
+						tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+							"id": tftypes.NewValue(tftypes.String, "two"),
+						}),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"id": testschema.Attribute{
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Required:    true,
+						},
+					},
+				},
+			},
+			path: path.Root("test").AtListIndex(3),
+			val: testNestedObject{
+				ID: types.StringValue("new"),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.List{ElementType: testNestedObjectType},
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "two"),
+					}),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot add list element 4 as list currently has 2 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
+				),
+			},
+		},
+		"set-element-add": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Set{ElementType: testNestedObjectType},
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, []tftypes.Value{
+						tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+							"id": tftypes.NewValue(tftypes.String, "one"),
+						}),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"id": testschema.Attribute{
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSet,
+							Required:    true,
+						},
+					},
+				},
+			},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"id": types.StringType,
+				},
+				map[string]attr.Value{
+					"id": types.StringValue("new"),
+				},
+			)),
+			val: testNestedObject{
+				ID: types.StringValue("new"),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.Set{ElementType: testNestedObjectType},
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, []tftypes.Value{
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "new"),
+					}),
+				}),
+			}),
+		},
+		"set-element-replace": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Set{ElementType: testNestedObjectType},
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, []tftypes.Value{
+						tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+							"id": tftypes.NewValue(tftypes.String, "one"),
+						}),
+						tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+							"id": tftypes.NewValue(tftypes.String, "two"),
+						}),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"id": testschema.Attribute{
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSet,
+							Required:    true,
+						},
+					},
+				},
+			},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"id": types.StringType,
+				},
+				map[string]attr.Value{
+					"id": types.StringValue("two"),
+				},
+			)),
+			val: testNestedObject{
+				ID: types.StringValue("new"),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.Set{ElementType: testNestedObjectType},
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.Set{ElementType: testNestedObjectType}, []tftypes.Value{
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+					tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "new"),
+					}),
+				}),
+			}),
+		},
 		"diagnostics": {
 			state: tfsdk.State{
 				Raw: tftypes.NewValue(tftypes.Object{
@@ -315,9 +315,10 @@ func TestValueAs(t *testing.T) {
 				diag.WithPath(
 					path.Empty(),
 					reflect.DiagIntoIncompatibleType{
-						Val:        tftypes.NewValue(tftypes.String, "hello"),
-						TargetType: goreflect.TypeOf(int64(0)),
-						Err:        fmt.Errorf("can't unmarshal %s into %T, expected *big.Float", tftypes.String, big.NewFloat(0)),
+						Val:           tftypes.NewValue(tftypes.String, "hello"),
+						TargetType:    goreflect.TypeOf(int64(0)),
+						Err:           fmt.Errorf("can't unmarshal %s into %T, expected *big.Float", tftypes.String, big.NewFloat(0)),
+						SuggestedType: "string or basetypes.StringValue",
 					},
 				),
 			},
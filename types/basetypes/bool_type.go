@@ -45,10 +45,14 @@ func (t BoolType) String() string {
 	return "basetypes.BoolType"
 }
 
+// boolTerraformType is tftypes.Bool as a tftypes.Type interface value, which
+// prevents an allocation on every TerraformType call.
+var boolTerraformType tftypes.Type = tftypes.Bool
+
 // TerraformType returns the tftypes.Type that should be used to represent this
 // framework type.
 func (t BoolType) TerraformType(_ context.Context) tftypes.Type {
-	return tftypes.Bool
+	return boolTerraformType
 }
 
 // ValueFromBool returns a BoolValuable type given a BoolValue.
@@ -187,6 +187,10 @@ func TestBoolValueIsNull(t *testing.T) {
 			input:    NewBoolUnknown(),
 			expected: false,
 		},
+		"zero-value": {
+			input:    BoolValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -49,10 +49,14 @@ func (t Float64Type) String() string {
 	return "basetypes.Float64Type"
 }
 
+// float64TerraformType is tftypes.Number as a tftypes.Type interface value, which
+// prevents an allocation on every TerraformType call.
+var float64TerraformType tftypes.Type = tftypes.Number
+
 // TerraformType returns the tftypes.Type that should be used to represent this
 // framework type.
 func (t Float64Type) TerraformType(_ context.Context) tftypes.Type {
-	return tftypes.Number
+	return float64TerraformType
 }
 
 // Validate implements type validation.
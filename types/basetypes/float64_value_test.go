@@ -257,6 +257,10 @@ func TestFloat64ValueIsNull(t *testing.T) {
 			input:    NewFloat64Unknown(),
 			expected: false,
 		},
+		"zero-value": {
+			input:    Float64Value{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
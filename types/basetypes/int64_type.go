@@ -48,10 +48,14 @@ func (t Int64Type) String() string {
 	return "basetypes.Int64Type"
 }
 
+// int64TerraformType is tftypes.Number as a tftypes.Type interface value, which
+// prevents an allocation on every TerraformType call.
+var int64TerraformType tftypes.Type = tftypes.Number
+
 // TerraformType returns the tftypes.Type that should be used to represent this
 // framework type.
 func (t Int64Type) TerraformType(_ context.Context) tftypes.Type {
-	return tftypes.Number
+	return int64TerraformType
 }
 
 // Validate implements type validation.
@@ -145,6 +145,10 @@ func TestInt64ValueIsNull(t *testing.T) {
 			input:    NewInt64Unknown(),
 			expected: false,
 		},
+		"zero-value": {
+			input:    Int64Value{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -122,6 +122,36 @@ func NewListValueFrom(ctx context.Context, elementType attr.Type, elements any)
 	return list, diags
 }
 
+// NewListValueFromSet creates a List from the elements of a Set, in the order
+// they are stored in the Set. A null or unknown Set creates a null or unknown
+// List. The Set element type must match the given element type.
+func NewListValueFromSet(ctx context.Context, elementType attr.Type, set SetValue) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !elementType.Equal(set.ElementType(ctx)) {
+		diags.AddError(
+			"Invalid List Element Type",
+			"While creating a List value from a Set value, an invalid element type was detected. "+
+				"A List must use the single, given element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("List Element Type: %s\n", elementType.String())+
+				fmt.Sprintf("Set Element Type: %s", set.ElementType(ctx)),
+		)
+
+		return NewListUnknown(elementType), diags
+	}
+
+	if set.IsNull() {
+		return NewListNull(elementType), nil
+	}
+
+	if set.IsUnknown() {
+		return NewListUnknown(elementType), nil
+	}
+
+	return NewListValue(elementType, set.Elements())
+}
+
 // NewListValueMust creates a List with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the List
 // type Elements or ElementsAs methods.
@@ -5,6 +5,7 @@ package basetypes
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -62,6 +63,43 @@ func TestNewListValue(t *testing.T) {
 				),
 			},
 		},
+		"invalid-element-types-mixed": {
+			elementType: NumberType{},
+			elements: []attr.Value{
+				NewStringValue("test"),
+				NewNumberValue(big.NewFloat(1.2)),
+				NewBoolValue(true),
+				NewNumberNull(),
+				NewInt64Value(1),
+			},
+			expected: NewListUnknown(NumberType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.NumberType\n"+
+						"List Index (0) Element Type: basetypes.StringType",
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.NumberType\n"+
+						"List Index (2) Element Type: basetypes.BoolType",
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.NumberType\n"+
+						"List Index (4) Element Type: basetypes.Int64Type",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -246,6 +284,219 @@ func TestListElementsAs_attributeValueSlice(t *testing.T) {
 	}
 }
 
+func TestListValueElementsAs(t *testing.T) {
+	t.Parallel()
+
+	type testObject struct {
+		Name  string      `tfsdk:"name"`
+		Count Int64Value  `tfsdk:"count"`
+		Tag   StringValue `tfsdk:"tag"`
+	}
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  StringType{},
+			"count": Int64Type{},
+			"tag":   StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		input         ListValue
+		target        func() any
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"primitives": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			target:   func() any { return &[]string{} },
+			expected: &[]string{"hello", "world"},
+		},
+		"primitives-null-element-pointer": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringValue("world"),
+				},
+			),
+			target:   func() any { return &[]*string{} },
+			expected: &[]*string{nil, pointer("world")},
+		},
+		"primitives-unknown-element-value": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringValue("world"),
+				},
+			),
+			target:   func() any { return &[]StringValue{} },
+			expected: &[]StringValue{NewStringUnknown(), NewStringValue("world")},
+		},
+		"objects": {
+			input: NewListValueMust(
+				testObjectType,
+				[]attr.Value{
+					NewObjectValueMust(
+						testObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("one"),
+							"count": NewInt64Value(1),
+							"tag":   NewStringNull(),
+						},
+					),
+					NewObjectValueMust(
+						testObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("two"),
+							"count": NewInt64Unknown(),
+							"tag":   NewStringValue("test"),
+						},
+					),
+				},
+			),
+			target: func() any { return &[]testObject{} },
+			expected: &[]testObject{
+				{
+					Name:  "one",
+					Count: NewInt64Value(1),
+					Tag:   NewStringNull(),
+				},
+				{
+					Name:  "two",
+					Count: NewInt64Unknown(),
+					Tag:   NewStringValue("test"),
+				},
+			},
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			target:   func() any { return &[]string{"existing"} },
+			expected: new([]string),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			target:   func() any { return &[]string{} },
+			expected: &[]string{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: \n"+
+						"Target Type: []string\n"+
+						"Suggested Type: basetypes.ListValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := testCase.target()
+
+			diags := testCase.input.ElementsAs(context.Background(), target, false)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewListValueFromSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		set           SetValue
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			elementType: StringType{},
+			set:         NewSetNull(StringType{}),
+			expected:    NewListNull(StringType{}),
+		},
+		"unknown": {
+			elementType: StringType{},
+			set:         NewSetUnknown(StringType{}),
+			expected:    NewListUnknown(StringType{}),
+		},
+		"valid-elements": {
+			elementType: StringType{},
+			set: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+				},
+			),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+				},
+			),
+		},
+		"invalid-element-type": {
+			elementType: StringType{},
+			set: NewSetValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(true),
+				},
+			),
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value from a Set value, an invalid element type was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"Set Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewListValueFromSet(context.Background(), testCase.elementType, testCase.set)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListValueToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -443,6 +694,38 @@ func TestListValueEqual(t *testing.T) {
 			),
 			expected: true,
 		},
+		"known-known-diff-order": {
+			receiver: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("world"),
+					NewStringValue("hello"),
+				},
+			),
+			expected: false,
+		},
+		"known-known-nested-diff-value": {
+			receiver: NewListValueMust(
+				ListType{ElemType: StringType{}},
+				[]attr.Value{
+					NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello")}),
+				},
+			),
+			input: NewListValueMust(
+				ListType{ElemType: StringType{}},
+				[]attr.Value{
+					NewListValueMust(StringType{}, []attr.Value{NewStringValue("world")}),
+				},
+			),
+			expected: false,
+		},
 		"known-known-diff-value": {
 			receiver: NewListValueMust(
 				StringType{},
@@ -568,6 +851,16 @@ func TestListValueEqual(t *testing.T) {
 			),
 			expected: false,
 		},
+		"null-null": {
+			receiver: NewListNull(StringType{}),
+			input:    NewListNull(StringType{}),
+			expected: true,
+		},
+		"unknown-unknown": {
+			receiver: NewListUnknown(StringType{}),
+			input:    NewListUnknown(StringType{}),
+			expected: true,
+		},
 		"known-nil": {
 			receiver: NewListValueMust(
 				StringType{},
@@ -612,6 +905,10 @@ func TestListValueIsNull(t *testing.T) {
 			input:    NewListUnknown(StringType{}),
 			expected: false,
 		},
+		"zero-value": {
+			input:    ListValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -211,6 +211,8 @@ func (m MapValue) Type(ctx context.Context) attr.Type {
 }
 
 // ToTerraformValue returns the data contained in the Map as a tftypes.Value.
+// Elements are converted in sorted key order, so the returned value and any
+// element conversion error are deterministic for the same Map.
 func (m MapValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	mapType := tftypes.Map{ElementType: m.ElementType(ctx).TerraformType(ctx)}
 
@@ -218,8 +220,14 @@ func (m MapValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	case attr.ValueStateKnown:
 		vals := make(map[string]tftypes.Value, len(m.elements))
 
-		for key, elem := range m.elements {
-			val, err := elem.ToTerraformValue(ctx)
+		keys := make([]string, 0, len(m.elements))
+		for key := range m.elements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			val, err := m.elements[key].ToTerraformValue(ctx)
 
 			if err != nil {
 				return tftypes.NewValue(mapType, tftypes.UnknownValue), err
@@ -5,11 +5,15 @@ package basetypes
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -249,6 +253,158 @@ func TestMapElementsAs_mapStringAttributeValue(t *testing.T) {
 	}
 }
 
+func TestMapValueElementsAs(t *testing.T) {
+	t.Parallel()
+
+	type testObject struct {
+		Name  string      `tfsdk:"name"`
+		Count Int64Value  `tfsdk:"count"`
+		Tag   StringValue `tfsdk:"tag"`
+	}
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  StringType{},
+			"count": Int64Type{},
+			"tag":   StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		input         MapValue
+		target        func() any
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"primitives": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"h": NewStringValue("hello"),
+					"w": NewStringValue("world"),
+				},
+			),
+			target: func() any { return new(map[string]string) },
+			expected: &map[string]string{
+				"h": "hello",
+				"w": "world",
+			},
+		},
+		"primitives-null-element-pointer": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"h": NewStringNull(),
+					"w": NewStringValue("world"),
+				},
+			),
+			target: func() any { return new(map[string]*string) },
+			expected: &map[string]*string{
+				"h": nil,
+				"w": pointer("world"),
+			},
+		},
+		"primitives-unknown-element-value": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"h": NewStringUnknown(),
+					"w": NewStringValue("world"),
+				},
+			),
+			target: func() any { return new(map[string]StringValue) },
+			expected: &map[string]StringValue{
+				"h": NewStringUnknown(),
+				"w": NewStringValue("world"),
+			},
+		},
+		"objects": {
+			input: NewMapValueMust(
+				testObjectType,
+				map[string]attr.Value{
+					"one": NewObjectValueMust(
+						testObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("one"),
+							"count": NewInt64Value(1),
+							"tag":   NewStringNull(),
+						},
+					),
+					"two": NewObjectValueMust(
+						testObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("two"),
+							"count": NewInt64Unknown(),
+							"tag":   NewStringValue("test"),
+						},
+					),
+				},
+			),
+			target: func() any { return new(map[string]testObject) },
+			expected: &map[string]testObject{
+				"one": {
+					Name:  "one",
+					Count: NewInt64Value(1),
+					Tag:   NewStringNull(),
+				},
+				"two": {
+					Name:  "two",
+					Count: NewInt64Unknown(),
+					Tag:   NewStringValue("test"),
+				},
+			},
+		},
+		"empty": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			target:   func() any { return new(map[string]string) },
+			expected: &map[string]string{},
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			target:   func() any { return &map[string]string{"existing": "value"} },
+			expected: new(map[string]string),
+		},
+		"invalid-element-type": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"h": NewStringValue("hello"),
+				},
+			),
+			target:   func() any { return new(map[string]bool) },
+			expected: new(map[string]bool),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty().AtMapKey("h"), refl.DiagIntoIncompatibleType{
+					Val:           tftypes.NewValue(tftypes.String, "hello"),
+					TargetType:    reflect.TypeOf(false),
+					Err:           errors.New("can't unmarshal tftypes.String into *bool, expected boolean"),
+					SuggestedType: "string or basetypes.StringValue",
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := testCase.target()
+
+			diags := testCase.input.ElementsAs(context.Background(), target, false)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapValueToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -337,6 +493,60 @@ func TestMapValueToTerraformValue(t *testing.T) {
 	}
 }
 
+func TestMapValueToTerraformValue_deterministic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newGoMap := func() map[string]string {
+		result := make(map[string]string, 50)
+
+		for i := 0; i < 50; i++ {
+			result[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
+		}
+
+		return result
+	}
+
+	expected, diags := NewMapValueFrom(ctx, StringType{}, newGoMap())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating expected value: %v", diags)
+	}
+
+	expectedTfValue, err := expected.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		got, diags := NewMapValueFrom(ctx, StringType{}, newGoMap())
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error creating value: %v", diags)
+		}
+
+		gotTfValue, err := got.ToTerraformValue(ctx)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !gotTfValue.Equal(expectedTfValue) {
+			t.Errorf("expected %s, got %s", expectedTfValue, gotTfValue)
+		}
+
+		if gotTfValue.String() != expectedTfValue.String() {
+			t.Errorf("expected string %s, got %s", expectedTfValue.String(), gotTfValue.String())
+		}
+
+		if got.String() != expected.String() {
+			t.Errorf("expected string %s, got %s", expected.String(), got.String())
+		}
+	}
+}
+
 func TestMapValueElements(t *testing.T) {
 	t.Parallel()
 
@@ -615,6 +825,10 @@ func TestMapValueIsNull(t *testing.T) {
 			input:    NewMapUnknown(StringType{}),
 			expected: false,
 		},
+		"zero-value": {
+			input:    MapValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -46,10 +46,14 @@ func (t NumberType) String() string {
 	return "basetypes.NumberType"
 }
 
+// numberTerraformType is tftypes.Number as a tftypes.Type interface value, which
+// prevents an allocation on every TerraformType call.
+var numberTerraformType tftypes.Type = tftypes.Number
+
 // TerraformType returns the tftypes.Type that should be used to represent this
 // framework type.
 func (t NumberType) TerraformType(_ context.Context) tftypes.Type {
-	return tftypes.Number
+	return numberTerraformType
 }
 
 // ValueFromNumber returns a NumberValuable type given a NumberValue.
@@ -6,7 +6,9 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -128,6 +130,36 @@ func (n NumberValue) Equal(other attr.Value) bool {
 	return n.value.Cmp(o.value) == 0
 }
 
+// GreaterThan returns a known Bool of whether the Number is greater than the
+// other Number. Comparisons cannot be known with null or unknown values, so
+// if either Number is null, a null Bool is returned, otherwise if either
+// Number is unknown, an unknown Bool is returned.
+func (n NumberValue) GreaterThan(other NumberValue) BoolValue {
+	return n.compare(other, func(result int) bool { return result > 0 })
+}
+
+// LessThan returns a known Bool of whether the Number is less than the other
+// Number. Comparisons cannot be known with null or unknown values, so if
+// either Number is null, a null Bool is returned, otherwise if either Number
+// is unknown, an unknown Bool is returned.
+func (n NumberValue) LessThan(other NumberValue) BoolValue {
+	return n.compare(other, func(result int) bool { return result < 0 })
+}
+
+// compare returns a Bool of the comparison function result, which receives
+// the big.Float Cmp result of both known values.
+func (n NumberValue) compare(other NumberValue, comparison func(int) bool) BoolValue {
+	if n.IsNull() || other.IsNull() {
+		return NewBoolNull()
+	}
+
+	if n.IsUnknown() || other.IsUnknown() {
+		return NewBoolUnknown()
+	}
+
+	return NewBoolValue(comparison(n.value.Cmp(other.value)))
+}
+
 // IsNull returns true if the Number represents a null value.
 func (n NumberValue) IsNull() bool {
 	return n.state == attr.ValueStateNull
@@ -159,6 +191,75 @@ func (n NumberValue) ValueBigFloat() *big.Float {
 	return n.value
 }
 
+// ValueFloat64 returns the known number value as a float64. If Number is null
+// or unknown, returns 0.0. Error diagnostics are returned if the value cannot
+// be represented as a 64-bit floating point due to overflow or underflow. If
+// the value is rounded to the nearest float64 value, a warning diagnostic is
+// returned with the rounded value.
+func (n NumberValue) ValueFloat64() (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n.state != attr.ValueStateKnown || n.value == nil {
+		return 0.0, diags
+	}
+
+	f, accuracy := n.value.Float64()
+
+	// Underflow or overflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float64
+	if (f == 0 && accuracy != big.Exact) || math.IsInf(f, 0) {
+		diags.AddError(
+			"Number Value Conversion Error",
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", n.value.String()),
+		)
+
+		return 0.0, diags
+	}
+
+	if accuracy != big.Exact {
+		diags.AddWarning(
+			"Number Value Conversion Precision Loss",
+			fmt.Sprintf("Value %s cannot be exactly represented as a 64-bit floating point and was rounded to %s.", n.value.String(), strconv.FormatFloat(f, 'g', -1, 64)),
+		)
+	}
+
+	return f, diags
+}
+
+// ValueInt64 returns the known number value as an int64. If Number is null or
+// unknown, returns 0. Diagnostics are returned if the value is not an integer
+// or cannot be represented as a 64-bit integer, rather than truncating the
+// value.
+func (n NumberValue) ValueInt64() (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n.state != attr.ValueStateKnown || n.value == nil {
+		return 0, diags
+	}
+
+	if !n.value.IsInt() {
+		diags.AddError(
+			"Number Value Conversion Error",
+			fmt.Sprintf("Value %s is not an integer.", n.value.String()),
+		)
+
+		return 0, diags
+	}
+
+	i, accuracy := n.value.Int64()
+
+	if accuracy != big.Exact {
+		diags.AddError(
+			"Number Value Conversion Error",
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer.", n.value.String()),
+		)
+
+		return 0, diags
+	}
+
+	return i, diags
+}
+
 // ToNumberValue returns Number.
 func (n NumberValue) ToNumberValue(context.Context) (NumberValue, diag.Diagnostics) {
 	return n, nil
@@ -11,6 +11,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -61,6 +63,63 @@ func TestNumberValueToTerraformValue(t *testing.T) {
 	}
 }
 
+func TestNumberValueRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"integer-30-digits":          "123456789012345678901234567890",
+		"integer-30-digits-negative": "-987654321098765432109876543210",
+		"decimal-high-precision":     "1234567890.12345678901234567890123456789",
+		"decimal-small":              "0.000000000000000000000000000001",
+	}
+	for name, input := range tests {
+		name, input := name, input
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			value, _, err := big.ParseFloat(input, 10, 512, big.ToNearestEven)
+			if err != nil {
+				t.Fatalf("Unexpected error parsing %q: %s", input, err)
+			}
+
+			tfValue, err := NewNumberValue(value).ToTerraformValue(ctx)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			// Round-trip through the protocol wire format.
+			dynamicValue, err := tfprotov6.NewDynamicValue(tftypes.Number, tfValue)
+			if err != nil {
+				t.Fatalf("Unexpected error creating dynamic value: %s", err)
+			}
+
+			wireValue, err := dynamicValue.Unmarshal(tftypes.Number)
+			if err != nil {
+				t.Fatalf("Unexpected error unmarshaling dynamic value: %s", err)
+			}
+
+			got, err := NumberType{}.ValueFromTerraform(ctx, wireValue)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			gotNumber, ok := got.(NumberValue)
+			if !ok {
+				t.Fatalf("Expected NumberValue, got %T", got)
+			}
+
+			if gotNumber.ValueBigFloat().Cmp(value) != 0 {
+				t.Errorf("Expected %s, got %s", value.Text('g', -1), gotNumber.ValueBigFloat().Text('g', -1))
+			}
+
+			if gotNumber.ValueBigFloat().Text('f', -1) != value.Text('f', -1) {
+				t.Errorf("Expected text %s, got %s", value.Text('f', -1), gotNumber.ValueBigFloat().Text('f', -1))
+			}
+		})
+	}
+}
+
 func TestNumberValueEqual(t *testing.T) {
 	t.Parallel()
 
@@ -179,6 +238,74 @@ func TestNumberValueEqual(t *testing.T) {
 	}
 }
 
+func TestNumberValueGreaterThan(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       NumberValue
+		candidate   NumberValue
+		expectation BoolValue
+	}
+	tests := map[string]testCase{
+		"known-equal": {
+			input:       NewNumberValue(big.NewFloat(123)),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolValue(false),
+		},
+		"known-less": {
+			input:       NewNumberValue(big.NewFloat(-1.5)),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolValue(false),
+		},
+		"known-greater": {
+			input:       NewNumberValue(big.NewFloat(456)),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolValue(true),
+		},
+		"known-null": {
+			input:       NewNumberValue(big.NewFloat(123)),
+			candidate:   NewNumberNull(),
+			expectation: NewBoolNull(),
+		},
+		"known-unknown": {
+			input:       NewNumberValue(big.NewFloat(123)),
+			candidate:   NewNumberUnknown(),
+			expectation: NewBoolUnknown(),
+		},
+		"null-known": {
+			input:       NewNumberNull(),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolNull(),
+		},
+		"null-unknown": {
+			input:       NewNumberNull(),
+			candidate:   NewNumberUnknown(),
+			expectation: NewBoolNull(),
+		},
+		"unknown-known": {
+			input:       NewNumberUnknown(),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolUnknown(),
+		},
+		"unknown-unknown": {
+			input:       NewNumberUnknown(),
+			candidate:   NewNumberUnknown(),
+			expectation: NewBoolUnknown(),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.GreaterThan(test.candidate)
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
 func TestNumberValueIsNull(t *testing.T) {
 	t.Parallel()
 
@@ -198,6 +325,10 @@ func TestNumberValueIsNull(t *testing.T) {
 			input:    NewNumberUnknown(),
 			expected: false,
 		},
+		"zero-value": {
+			input:    NumberValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -251,6 +382,74 @@ func TestNumberValueIsUnknown(t *testing.T) {
 	}
 }
 
+func TestNumberValueLessThan(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       NumberValue
+		candidate   NumberValue
+		expectation BoolValue
+	}
+	tests := map[string]testCase{
+		"known-equal": {
+			input:       NewNumberValue(big.NewFloat(123)),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolValue(false),
+		},
+		"known-less": {
+			input:       NewNumberValue(big.NewFloat(-1.5)),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolValue(true),
+		},
+		"known-greater": {
+			input:       NewNumberValue(big.NewFloat(456)),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolValue(false),
+		},
+		"known-null": {
+			input:       NewNumberValue(big.NewFloat(123)),
+			candidate:   NewNumberNull(),
+			expectation: NewBoolNull(),
+		},
+		"known-unknown": {
+			input:       NewNumberValue(big.NewFloat(123)),
+			candidate:   NewNumberUnknown(),
+			expectation: NewBoolUnknown(),
+		},
+		"null-known": {
+			input:       NewNumberNull(),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolNull(),
+		},
+		"null-unknown": {
+			input:       NewNumberNull(),
+			candidate:   NewNumberUnknown(),
+			expectation: NewBoolNull(),
+		},
+		"unknown-known": {
+			input:       NewNumberUnknown(),
+			candidate:   NewNumberValue(big.NewFloat(123)),
+			expectation: NewBoolUnknown(),
+		},
+		"unknown-unknown": {
+			input:       NewNumberUnknown(),
+			candidate:   NewNumberUnknown(),
+			expectation: NewBoolUnknown(),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.LessThan(test.candidate)
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
 func TestNumberValueString(t *testing.T) {
 	t.Parallel()
 
@@ -355,3 +554,155 @@ func TestNumberValueValueBigFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberValueValueFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      float64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(2.4)),
+			expected: 2.4,
+		},
+		"known-integer": {
+			input:    NewNumberValue(big.NewFloat(123)),
+			expected: 123.0,
+		},
+		"known-nil": {
+			input:    NewNumberValue(nil),
+			expected: 0.0,
+		},
+		"known-inexact": {
+			input:    NewNumberValue(new(big.Float).SetPrec(512).Quo(big.NewFloat(1), big.NewFloat(3))),
+			expected: 1.0 / 3.0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Number Value Conversion Precision Loss",
+					"Value 0.3333333333 cannot be exactly represented as a 64-bit floating point and was rounded to 0.3333333333333333.",
+				),
+			},
+		},
+		"known-overflow": {
+			input:    NewNumberValue(new(big.Float).Mul(big.NewFloat(math.MaxFloat64), big.NewFloat(10))),
+			expected: 0.0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Value Conversion Error",
+					"Value 1.797693135e+309 cannot be represented as a 64-bit floating point.",
+				),
+			},
+		},
+		"known-underflow": {
+			input:    NewNumberValue(new(big.Float).Quo(big.NewFloat(math.SmallestNonzeroFloat64), big.NewFloat(10))),
+			expected: 0.0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Value Conversion Error",
+					"Value 4.940656458e-325 cannot be represented as a 64-bit floating point.",
+				),
+			},
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0.0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0.0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueFloat64()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumberValueValueInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      int64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(123)),
+			expected: 123,
+		},
+		"known-negative": {
+			input:    NewNumberValue(big.NewFloat(-123)),
+			expected: -123,
+		},
+		"known-max": {
+			input:    NewNumberValue(new(big.Float).SetInt64(math.MaxInt64)),
+			expected: math.MaxInt64,
+		},
+		"known-nil": {
+			input:    NewNumberValue(nil),
+			expected: 0,
+		},
+		"known-fractional": {
+			input:    NewNumberValue(big.NewFloat(2.4)),
+			expected: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Value Conversion Error",
+					"Value 2.4 is not an integer.",
+				),
+			},
+		},
+		"known-overflow": {
+			input:    NewNumberValue(new(big.Float).Add(new(big.Float).SetInt64(math.MaxInt64), big.NewFloat(1))),
+			expected: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Value Conversion Error",
+					"Value 9.223372037e+18 cannot be represented as a 64-bit integer.",
+				),
+			},
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueInt64()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
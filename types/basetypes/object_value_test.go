@@ -595,6 +595,154 @@ func TestObjectAs_struct(t *testing.T) {
 	}
 }
 
+func TestObjectValueAs(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name  string `tfsdk:"name"`
+		Count int64  `tfsdk:"count"`
+	}
+
+	type testStructFrameworkTypes struct {
+		Name  StringValue `tfsdk:"name"`
+		Count Int64Value  `tfsdk:"count"`
+	}
+
+	testAttributeTypes := map[string]attr.Type{
+		"name":  StringType{},
+		"count": Int64Type{},
+	}
+
+	testCases := map[string]struct {
+		input         ObjectValue
+		opts          ObjectAsOptions
+		target        func() any
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"known-strict": {
+			input: NewObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("test"),
+					"count": NewInt64Value(1),
+				},
+			),
+			target: func() any { return new(testStruct) },
+			expected: &testStruct{
+				Name:  "test",
+				Count: 1,
+			},
+		},
+		"null-attribute-strict": {
+			input: NewObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("test"),
+					"count": NewInt64Null(),
+				},
+			),
+			target:   func() any { return new(testStruct) },
+			expected: new(testStruct),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("count"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: count\nTarget Type: int64\nSuggested `types` Type: basetypes.Int64Value\nSuggested Pointer Type: *int64",
+				),
+			},
+		},
+		"null-attribute-lenient": {
+			input: NewObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("test"),
+					"count": NewInt64Null(),
+				},
+			),
+			opts: ObjectAsOptions{
+				UnhandledNullAsEmpty: true,
+			},
+			target: func() any { return new(testStruct) },
+			expected: &testStruct{
+				Name: "test",
+			},
+		},
+		"unknown-attribute-strict": {
+			input: NewObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("test"),
+					"count": NewInt64Unknown(),
+				},
+			),
+			target:   func() any { return new(testStruct) },
+			expected: new(testStruct),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("count"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: count\nTarget Type: int64\nSuggested Type: basetypes.Int64Value",
+				),
+			},
+		},
+		"unknown-attribute-lenient": {
+			input: NewObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("test"),
+					"count": NewInt64Unknown(),
+				},
+			),
+			opts: ObjectAsOptions{
+				UnhandledUnknownAsEmpty: true,
+			},
+			target: func() any { return new(testStruct) },
+			expected: &testStruct{
+				Name: "test",
+			},
+		},
+		"unknown-attribute-framework-types": {
+			input: NewObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("test"),
+					"count": NewInt64Unknown(),
+				},
+			),
+			target: func() any { return new(testStructFrameworkTypes) },
+			expected: &testStructFrameworkTypes{
+				Name:  NewStringValue("test"),
+				Count: NewInt64Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := testCase.target()
+
+			diags := testCase.input.As(context.Background(), target, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestObjectValueAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -1514,6 +1662,10 @@ func TestObjectValueIsNull(t *testing.T) {
 			input:    NewObjectUnknown(map[string]attr.Type{"test_attr": StringType{}}),
 			expected: false,
 		},
+		"zero-value": {
+			input:    ObjectValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
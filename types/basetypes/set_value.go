@@ -122,6 +122,47 @@ func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 	return set, diags
 }
 
+// NewSetValueFromList creates a Set from the elements of a List, removing
+// duplicate elements while preserving the order of their first occurrence. A
+// null or unknown List creates a null or unknown Set. The List element type
+// must match the given element type.
+func NewSetValueFromList(ctx context.Context, elementType attr.Type, list ListValue) (SetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !elementType.Equal(list.ElementType(ctx)) {
+		diags.AddError(
+			"Invalid Set Element Type",
+			"While creating a Set value from a List value, an invalid element type was detected. "+
+				"A Set must use the single, given element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Set Element Type: %s\n", elementType.String())+
+				fmt.Sprintf("List Element Type: %s", list.ElementType(ctx)),
+		)
+
+		return NewSetUnknown(elementType), diags
+	}
+
+	if list.IsNull() {
+		return NewSetNull(elementType), nil
+	}
+
+	if list.IsUnknown() {
+		return NewSetUnknown(elementType), nil
+	}
+
+	elements := make([]attr.Value, 0, len(list.elements))
+
+	for _, element := range list.elements {
+		if (SetValue{elements: elements}).contains(element) {
+			continue
+		}
+
+		elements = append(elements, element)
+	}
+
+	return NewSetValue(elementType, elements)
+}
+
 // NewSetValueMust creates a Set with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Set
 // type Elements or ElementsAs methods.
@@ -5,6 +5,7 @@ package basetypes
 
 import (
 	"context"
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -60,6 +61,142 @@ func TestSetElementsAs_attributeValueSlice(t *testing.T) {
 	}
 }
 
+func TestSetValueElementsAs(t *testing.T) {
+	t.Parallel()
+
+	type testObject struct {
+		Name  string      `tfsdk:"name"`
+		Count Int64Value  `tfsdk:"count"`
+		Tag   StringValue `tfsdk:"tag"`
+	}
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  StringType{},
+			"count": Int64Type{},
+			"tag":   StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		input         SetValue
+		target        func() any
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"primitives": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			target:   func() any { return &[]string{} },
+			expected: &[]string{"hello", "world"},
+		},
+		"primitives-null-element-pointer": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringValue("world"),
+				},
+			),
+			target:   func() any { return &[]*string{} },
+			expected: &[]*string{nil, pointer("world")},
+		},
+		"primitives-unknown-element-value": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringValue("world"),
+				},
+			),
+			target:   func() any { return &[]StringValue{} },
+			expected: &[]StringValue{NewStringUnknown(), NewStringValue("world")},
+		},
+		"objects": {
+			input: NewSetValueMust(
+				testObjectType,
+				[]attr.Value{
+					NewObjectValueMust(
+						testObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("one"),
+							"count": NewInt64Value(1),
+							"tag":   NewStringNull(),
+						},
+					),
+					NewObjectValueMust(
+						testObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("two"),
+							"count": NewInt64Unknown(),
+							"tag":   NewStringValue("test"),
+						},
+					),
+				},
+			),
+			target: func() any { return &[]testObject{} },
+			expected: &[]testObject{
+				{
+					Name:  "one",
+					Count: NewInt64Value(1),
+					Tag:   NewStringNull(),
+				},
+				{
+					Name:  "two",
+					Count: NewInt64Unknown(),
+					Tag:   NewStringValue("test"),
+				},
+			},
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			target:   func() any { return &[]string{"existing"} },
+			expected: new([]string),
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			target:   func() any { return &[]string{} },
+			expected: &[]string{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: \n"+
+						"Target Type: []string\n"+
+						"Suggested Type: basetypes.SetValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := testCase.target()
+
+			diags := testCase.input.ElementsAs(context.Background(), target, false)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 var benchDiags diag.Diagnostics // Prevent compiler optimization
 
 func benchmarkSetTypeValidate(b *testing.B, elementCount int) {
@@ -267,6 +404,89 @@ func TestSetTypeValidate(t *testing.T) {
 				),
 			},
 		},
+		"values-duplicates-objects": {
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_attr": tftypes.String,
+						},
+					},
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test_attr": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"test_attr": tftypes.NewValue(tftypes.String, "hello"),
+						},
+					),
+					tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test_attr": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"test_attr": tftypes.NewValue(tftypes.String, "world"),
+						},
+					),
+					tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test_attr": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"test_attr": tftypes.NewValue(tftypes.String, "hello"),
+						},
+					),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.Object[\"test_attr\":tftypes.String]<\"test_attr\":tftypes.String<\"hello\">>",
+				),
+			},
+		},
+		"values-duplicates-objects-partially-unknown": {
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_attr": tftypes.String,
+						},
+					},
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test_attr": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"test_attr": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						},
+					),
+					tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test_attr": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"test_attr": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						},
+					),
+				},
+			),
+		},
 		"wrong-value-type": {
 			in: tftypes.NewValue(tftypes.List{
 				ElementType: tftypes.String,
@@ -345,6 +565,43 @@ func TestNewSetValue(t *testing.T) {
 				),
 			},
 		},
+		"invalid-element-types-mixed": {
+			elementType: NumberType{},
+			elements: []attr.Value{
+				NewStringValue("test"),
+				NewNumberValue(big.NewFloat(1.2)),
+				NewBoolValue(true),
+				NewNumberNull(),
+				NewInt64Value(1),
+			},
+			expected: NewSetUnknown(NumberType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.NumberType\n"+
+						"Set Index (0) Element Type: basetypes.StringType",
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.NumberType\n"+
+						"Set Index (2) Element Type: basetypes.BoolType",
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.NumberType\n"+
+						"Set Index (4) Element Type: basetypes.Int64Type",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -484,6 +741,110 @@ func TestNewSetValueFrom(t *testing.T) {
 	}
 }
 
+func TestNewSetValueFromList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		list          ListValue
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			elementType: StringType{},
+			list:        NewListNull(StringType{}),
+			expected:    NewSetNull(StringType{}),
+		},
+		"unknown": {
+			elementType: StringType{},
+			list:        NewListUnknown(StringType{}),
+			expected:    NewSetUnknown(StringType{}),
+		},
+		"valid-no-elements": {
+			elementType: StringType{},
+			list:        NewListValueMust(StringType{}, []attr.Value{}),
+			expected:    NewSetValueMust(StringType{}, []attr.Value{}),
+		},
+		"valid-elements": {
+			elementType: StringType{},
+			list: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+				},
+			),
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+				},
+			),
+		},
+		"valid-elements-duplicate": {
+			elementType: StringType{},
+			list: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+					NewStringValue("one"),
+					NewStringNull(),
+					NewStringValue("two"),
+					NewStringNull(),
+				},
+			),
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+					NewStringNull(),
+				},
+			),
+		},
+		"invalid-element-type": {
+			elementType: StringType{},
+			list: NewListValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(true),
+				},
+			),
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value from a List value, an invalid element type was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"List Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewSetValueFromList(context.Background(), testCase.elementType, testCase.list)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetValueToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -696,6 +1057,38 @@ func TestSetValueEqual(t *testing.T) {
 			),
 			expected: true,
 		},
+		"known-known-diff-order": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("world"),
+					NewStringValue("hello"),
+				},
+			),
+			expected: true,
+		},
+		"known-known-nested-diff-value": {
+			receiver: NewSetValueMust(
+				ListType{ElemType: StringType{}},
+				[]attr.Value{
+					NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello")}),
+				},
+			),
+			input: NewSetValueMust(
+				ListType{ElemType: StringType{}},
+				[]attr.Value{
+					NewListValueMust(StringType{}, []attr.Value{NewStringValue("world")}),
+				},
+			),
+			expected: false,
+		},
 		"known-known-diff-value": {
 			receiver: NewSetValueMust(
 				StringType{},
@@ -821,6 +1214,16 @@ func TestSetValueEqual(t *testing.T) {
 			),
 			expected: false,
 		},
+		"null-null": {
+			receiver: NewSetNull(StringType{}),
+			input:    NewSetNull(StringType{}),
+			expected: true,
+		},
+		"unknown-unknown": {
+			receiver: NewSetUnknown(StringType{}),
+			input:    NewSetUnknown(StringType{}),
+			expected: true,
+		},
 		"known-nil": {
 			receiver: NewSetValueMust(
 				StringType{},
@@ -865,6 +1268,10 @@ func TestSetValueIsNull(t *testing.T) {
 			input:    NewSetUnknown(StringType{}),
 			expected: false,
 		},
+		"zero-value": {
+			input:    SetValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -45,10 +45,14 @@ func (t StringType) String() string {
 	return "basetypes.StringType"
 }
 
+// stringTerraformType is tftypes.String as a tftypes.Type interface value, which
+// prevents an allocation on every TerraformType call.
+var stringTerraformType tftypes.Type = tftypes.String
+
 // TerraformType returns the tftypes.Type that should be used to represent this
 // framework type.
 func (t StringType) TerraformType(_ context.Context) tftypes.Type {
-	return tftypes.String
+	return stringTerraformType
 }
 
 // ValueFromString returns a StringValuable type given a StringValue.
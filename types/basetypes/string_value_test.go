@@ -143,6 +143,10 @@ func TestStringValueIsNull(t *testing.T) {
 			input:    NewStringUnknown(),
 			expected: false,
 		},
+		"zero-value": {
+			input:    StringValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
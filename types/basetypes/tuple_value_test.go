@@ -429,6 +429,10 @@ func TestTupleValueIsNull(t *testing.T) {
 			input:    NewTupleUnknown([]attr.Type{StringType{}, BoolType{}}),
 			expected: false,
 		},
+		"zero-value": {
+			input:    TupleValue{},
+			expected: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package enumtypes contains framework types and values which only allow a
+// fixed set of values, such as a string enumeration.
+package enumtypes
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = StringType{}
+	_ xattr.TypeWithValidate  = StringType{}
+)
+
+// StringType is a framework type for a string which must be one of a
+// fixed set of allowed values. StringValue is the associated value type.
+//
+// Known values which are not in AllowedValues raise an error diagnostic on
+// the attribute path when the framework reads the value, such as during
+// configuration validation. Null and unknown values are always valid.
+type StringType struct {
+	// AllowedValues is the set of valid string values. The order is used
+	// for diagnostic and type string output.
+	AllowedValues []string
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t StringType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is equivalent, including having the
+// same allowed values in the same order.
+func (t StringType) Equal(o attr.Type) bool {
+	other, ok := o.(StringType)
+
+	if !ok {
+		return false
+	}
+
+	return allowedValuesEqual(t.AllowedValues, other.AllowedValues)
+}
+
+// String returns a human readable string of the type name.
+func (t StringType) String() string {
+	return "enumtypes.StringType" + t.allowedValuesString()
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// framework type.
+func (t StringType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate returns an error diagnostic if the given known value is not one of
+// the allowed values.
+func (t StringType) Validate(_ context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"String Enum Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	for _, allowedValue := range t.AllowedValues {
+		if s == allowedValue {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		valuePath,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s value must be one of: %s, got: %q", valuePath, t.allowedValuesString(), s),
+	)
+
+	return diags
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t StringType) ValueFromString(_ context.Context, v basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return StringValue{
+		StringValue:   v,
+		allowedValues: t.AllowedValues,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t StringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := basetypes.StringType{}.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return StringValue{
+		StringValue:   stringValue,
+		allowedValues: t.AllowedValues,
+	}, nil
+}
+
+// ValueType returns the Value type.
+func (t StringType) ValueType(_ context.Context) attr.Value {
+	// This Value does not need to be valid.
+	return StringValue{
+		allowedValues: t.AllowedValues,
+	}
+}
+
+// allowedValuesString returns the quoted allowed values in brackets.
+func (t StringType) allowedValuesString() string {
+	return fmt.Sprintf("%q", t.AllowedValues)
+}
+
+// allowedValuesEqual returns true if both allowed values slices
+// contain the same values in the same order.
+func allowedValuesEqual(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
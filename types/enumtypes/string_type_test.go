@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestStringTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      StringType
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			typ:      StringType{AllowedValues: []string{"one", "two"}},
+			other:    StringType{AllowedValues: []string{"one", "two"}},
+			expected: true,
+		},
+		"different-allowed-values": {
+			typ:   StringType{AllowedValues: []string{"one", "two"}},
+			other: StringType{AllowedValues: []string{"one", "three"}},
+		},
+		"different-allowed-values-order": {
+			typ:   StringType{AllowedValues: []string{"one", "two"}},
+			other: StringType{AllowedValues: []string{"two", "one"}},
+		},
+		"different-type": {
+			typ:   StringType{AllowedValues: []string{"one", "two"}},
+			other: basetypes.StringType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestStringTypeString(t *testing.T) {
+	t.Parallel()
+
+	got := StringType{AllowedValues: []string{"one", "two"}}.String()
+	expected := `enumtypes.StringType["one" "two"]`
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestStringTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testType := StringType{AllowedValues: []string{"one", "two"}}
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			in: tftypes.Value{},
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"allowed-value": {
+			in: tftypes.NewValue(tftypes.String, "two"),
+		},
+		"disallowed-value": {
+			in: tftypes.NewValue(tftypes.String, "three"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Invalid Attribute Value",
+					`Attribute test[0] value must be one of: ["one" "two"], got: "three"`,
+				),
+			},
+		},
+		"wrong-value-type": {
+			in: tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"String Enum Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testType.Validate(context.Background(), testCase.in, path.Root("test").AtListIndex(0))
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testAllowedValues := []string{"one", "two"}
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			in:       tftypes.NewValue(tftypes.String, "one"),
+			expected: NewStringValueMust("one", testAllowedValues),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: NewStringNull(testAllowedValues),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: NewStringUnknown(testAllowedValues),
+		},
+		"wrong-type": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := StringType{AllowedValues: testAllowedValues}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringValueEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    StringValue
+		other    attr.Value
+		expected bool
+	}{
+		"equal": {
+			value:    NewStringValueMust("one", []string{"one", "two"}),
+			other:    NewStringValueMust("one", []string{"one", "two"}),
+			expected: true,
+		},
+		"different-value": {
+			value: NewStringValueMust("one", []string{"one", "two"}),
+			other: NewStringValueMust("two", []string{"one", "two"}),
+		},
+		"different-allowed-values": {
+			value: NewStringValueMust("one", []string{"one", "two"}),
+			other: NewStringValueMust("one", []string{"one", "three"}),
+		},
+		"different-value-type": {
+			value: NewStringValueMust("one", []string{"one", "two"}),
+			other: basetypes.NewStringValue("one"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestStringValueType(t *testing.T) {
+	t.Parallel()
+
+	got := NewStringValueMust("one", []string{"one", "two"}).Type(context.Background())
+	expected := StringType{AllowedValues: []string{"one", "two"}}
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestNewStringValue(t *testing.T) {
+	t.Parallel()
+
+	testAllowedValues := []string{"one", "two"}
+
+	testCases := map[string]struct {
+		value         string
+		expected      StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"allowed-value": {
+			value:    "two",
+			expected: NewStringValueMust("two", testAllowedValues),
+		},
+		"disallowed-value": {
+			value:    "three",
+			expected: NewStringUnknown(testAllowedValues),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid String Enum Value",
+					"While creating a String Enum value, an invalid value was detected. "+
+						"A String Enum value must be one of the allowed values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Allowed Values: [\"one\" \"two\"]\n"+
+						"Value: \"three\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewStringValue(testCase.value, testAllowedValues)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
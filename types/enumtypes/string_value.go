@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable = StringValue{}
+)
+
+// StringValue represents a string value of a StringType. Access the
+// value via the embedded basetypes.StringValue methods, such as ValueString.
+type StringValue struct {
+	basetypes.StringValue
+
+	// allowedValues is the set of valid string values of the associated
+	// StringType.
+	allowedValues []string
+}
+
+// NewStringValue creates a StringValue with a known value. An error
+// diagnostic is returned if the value is not one of the allowed values, in
+// which case the returned StringValue is unknown.
+func NewStringValue(value string, allowedValues []string) (StringValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, allowedValue := range allowedValues {
+		if value == allowedValue {
+			return StringValue{
+				StringValue:   basetypes.NewStringValue(value),
+				allowedValues: allowedValues,
+			}, diags
+		}
+	}
+
+	diags.AddError(
+		"Invalid String Enum Value",
+		"While creating a String Enum value, an invalid value was detected. "+
+			"A String Enum value must be one of the allowed values. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Allowed Values: %q\n", allowedValues)+
+			fmt.Sprintf("Value: %q", value),
+	)
+
+	return NewStringUnknown(allowedValues), diags
+}
+
+// NewStringValueMust creates a StringValue with a known value, converting any
+// diagnostics into a panic at runtime.
+//
+// This creation function is only recommended to create StringValue values
+// which will not potentially affect practitioners, such as testing, or
+// exhaustively tested provider logic.
+func NewStringValueMust(value string, allowedValues []string) StringValue {
+	stringValue, diags := NewStringValue(value, allowedValues)
+
+	if diags.HasError() {
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewStringValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return stringValue
+}
+
+// NewStringNull creates a StringValue with a null value.
+func NewStringNull(allowedValues []string) StringValue {
+	return StringValue{
+		StringValue:   basetypes.NewStringNull(),
+		allowedValues: allowedValues,
+	}
+}
+
+// NewStringUnknown creates a StringValue with an unknown value.
+func NewStringUnknown(allowedValues []string) StringValue {
+	return StringValue{
+		StringValue:   basetypes.NewStringUnknown(),
+		allowedValues: allowedValues,
+	}
+}
+
+// Equal returns true if the given value is a StringValue with the same
+// allowed values and string value.
+func (v StringValue) Equal(o attr.Value) bool {
+	other, ok := o.(StringValue)
+
+	if !ok {
+		return false
+	}
+
+	if !allowedValuesEqual(v.allowedValues, other.allowedValues) {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns a StringType with the same allowed values.
+func (v StringValue) Type(_ context.Context) attr.Type {
+	return StringType{
+		AllowedValues: v.allowedValues,
+	}
+}
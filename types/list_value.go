@@ -38,6 +38,13 @@ func ListValueFrom(ctx context.Context, elementType attr.Type, elements any) (ba
 	return basetypes.NewListValueFrom(ctx, elementType, elements)
 }
 
+// ListValueFromSet creates a List from the elements of a Set. A null or
+// unknown Set creates a null or unknown List. The Set element type must match
+// the given element type.
+func ListValueFromSet(ctx context.Context, elementType attr.Type, set basetypes.SetValue) (basetypes.ListValue, diag.Diagnostics) {
+	return basetypes.NewListValueFromSet(ctx, elementType, set)
+}
+
 // ListValueMust creates a List with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the List
 // type Elements or ElementsAs methods.
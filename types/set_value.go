@@ -38,6 +38,13 @@ func SetValueFrom(ctx context.Context, elementType attr.Type, elements any) (bas
 	return basetypes.NewSetValueFrom(ctx, elementType, elements)
 }
 
+// SetValueFromList creates a Set from the elements of a List, removing
+// duplicate elements. A null or unknown List creates a null or unknown Set.
+// The List element type must match the given element type.
+func SetValueFromList(ctx context.Context, elementType attr.Type, list basetypes.ListValue) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetValueFromList(ctx, elementType, list)
+}
+
 // SetValueMust creates a Set with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Set
 // type Elements or ElementsAs methods.